@@ -1,51 +1,243 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // HostURL - Default Hashicups URL
 const HostURL string = "http://localhost:8080"
 
+// DefaultTimeout is the per-attempt time limit used by NewClient.
+const DefaultTimeout = 10 * time.Second
+
+// Default retry settings used by NewClient.
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 500 * time.Millisecond
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
 // Client -
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a failed request is retried. Zero
+	// disables retries.
+	MaxRetries int
+	// RetryWaitMin and RetryMaxWait bound the backoff between attempts.
+	RetryWaitMin time.Duration
+	RetryMaxWait time.Duration
+
+	// Token is sent as a bearer token when set. Otherwise Username and
+	// Password are sent as basic auth when Username is set.
+	Token    string
+	Username string
+	Password string
+
+	// SensitiveLogFields lists extra JSON keys masked in logged bodies.
+	// MaxLogBodySize caps logged bodies; zero means DefaultMaxLogBodySize.
+	SensitiveLogFields []string
+	MaxLogBodySize     int
+
+	// PageSize is sent as the limit on list requests. Zero leaves the page
+	// size to the server.
+	PageSize int
+
+	// AllowedEmailDomains restricts the domains of engineer emails. The
+	// client does not enforce it; it travels here so resources can check
+	// plans against the provider configuration.
+	AllowedEmailDomains []string
+
+	// teamLocks holds a *sync.Mutex per team, keyed by kind and team ID,
+	// serializing the read-modify-write membership helpers.
+	teamLocks sync.Map
+}
+
+// Option configures a Client built by NewClient.
+type Option func(*Client) error
+
+// WithToken authenticates every request with a bearer token.
+func WithToken(token string) Option {
+	return func(c *Client) error {
+		c.Token = token
+		return nil
+	}
+}
+
+// WithBasicAuth authenticates every request with HTTP basic auth.
+func WithBasicAuth(username, password string) Option {
+	return func(c *Client) error {
+		c.Username = username
+		c.Password = password
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit for a single HTTP attempt, including
+// reading the response body. Zero means no limit.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative, got %s", timeout)
+		}
+
+		c.HTTPClient.Timeout = timeout
+		return nil
+	}
+}
+
+// WithProxy routes every request through proxyURL. Without this option the
+// standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables are honored.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(c *Client) error {
+		c.transport().Proxy = http.ProxyURL(proxyURL)
+		return nil
+	}
+}
+
+// WithPageSize sets the number of items requested per page from list
+// endpoints.
+func WithPageSize(size int) Option {
+	return func(c *Client) error {
+		if size < 0 {
+			return fmt.Errorf("page size must not be negative, got %d", size)
+		}
+
+		c.PageSize = size
+		return nil
+	}
 }
 
 // NewClient -
-func NewClient(host *string) (*Client, error) {
+func NewClient(host *string, opts ...Option) (*Client, error) {
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient: &http.Client{
+			Timeout:   DefaultTimeout,
+			Transport: newTransport(),
+		},
 		// Default Hashicups URL
-		HostURL: HostURL,
+		HostURL:      HostURL,
+		MaxRetries:   DefaultMaxRetries,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryMaxWait: DefaultRetryMaxWait,
 	}
 
 	if host != nil {
 		c.HostURL = *host
 	}
 
+	for _, opt := range opts {
+		if err := opt(&c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Token != "" && c.Username != "" {
+		return nil, errors.New("token and basic auth credentials are mutually exclusive")
+	}
+
 	return &c, nil
 }
 
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+	var (
+		res  *http.Response
+		body []byte
+		err  error
+	)
+
+	c.setAuth(req)
+
+	ctx := c.logContext(req.Context())
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "url", req.URL.Redacted())
+
+	var reqBody []byte
+	if req.GetBody != nil {
+		if rc, gerr := req.GetBody(); gerr == nil {
+			reqBody, _ = io.ReadAll(rc)
+			rc.Close()
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		attemptCtx := tflog.SubsystemSetField(ctx, LogSubsystem, "attempt", attempt+1)
+		tflog.SubsystemDebug(attemptCtx, LogSubsystem, "Sending API request")
+		tflog.SubsystemTrace(attemptCtx, LogSubsystem, "API request details", map[string]any{
+			"headers": logHeaders(req.Header),
+			"body":    c.logBody(reqBody),
+		})
+
+		start := time.Now()
+		res, err = c.HTTPClient.Do(req)
+		if err == nil {
+			body, err = io.ReadAll(res.Body)
+			res.Body.Close()
+		}
+		duration := time.Since(start)
+
+		if err != nil {
+			tflog.SubsystemDebug(attemptCtx, LogSubsystem, "API request failed", map[string]any{
+				"duration_ms": duration.Milliseconds(),
+				"error":       err.Error(),
+			})
+		} else {
+			tflog.SubsystemDebug(attemptCtx, LogSubsystem, "Received API response", map[string]any{
+				"status":      res.StatusCode,
+				"duration_ms": duration.Milliseconds(),
+			})
+			tflog.SubsystemTrace(attemptCtx, LogSubsystem, "API response details", map[string]any{
+				"headers": logHeaders(res.Header),
+				"body":    c.logBody(body),
+			})
+		}
+
+		if attempt >= c.MaxRetries || !shouldRetry(req, res, err) {
+			break
+		}
+
+		wait := c.backoff(attempt, res)
+		tflog.SubsystemDebug(attemptCtx, LogSubsystem, "Retrying API request", map[string]any{
+			"wait_ms": wait.Milliseconds(),
+		})
+
+		if werr := sleepContext(req.Context(), wait); werr != nil {
+			return nil, werr
+		}
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
 	if !(res.StatusCode == http.StatusOK || res.StatusCode == http.StatusCreated) {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, newAPIError(req, res.StatusCode, body)
 	}
 
 	return body, err
 }
+
+func (c *Client) setAuth(req *http.Request) {
+	switch {
+	case c.Token != "":
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	case c.Username != "":
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+}
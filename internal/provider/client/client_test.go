@@ -1,8 +1,11 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -16,7 +19,7 @@ func TestNewClient(t *testing.T) {
 		if client.HostURL != HostURL {
 			t.Errorf("expected HostURL to be %s, got %s", HostURL, client.HostURL)
 		}
-		if client.HTTPClient.Timeout != 10*time.Second {
+		if client.HTTPClient.Timeout != DefaultTimeout {
 			t.Errorf("expected timeout to be 10s, got %v", client.HTTPClient.Timeout)
 		}
 	})
@@ -101,4 +104,112 @@ func TestDoRequest(t *testing.T) {
 			t.Fatal("expected error, got nil")
 		}
 	})
+	t.Run("honors context cancellation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		defer server.Close()
+
+		client := &Client{
+			HostURL:    server.URL,
+			HTTPClient: &http.Client{},
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+		_, err := client.doRequest(req)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
+func TestClientAuth(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("sends bearer token", func(t *testing.T) {
+		client, err := NewClient(&server.URL, WithToken("s3cret"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if gotAuth != "Bearer s3cret" {
+			t.Errorf("expected bearer token header, got %q", gotAuth)
+		}
+	})
+
+	t.Run("sends basic auth", func(t *testing.T) {
+		client, err := NewClient(&server.URL, WithBasicAuth("alice", "pw"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expected, _ := http.NewRequest("GET", server.URL, nil)
+		expected.SetBasicAuth("alice", "pw")
+		if gotAuth != expected.Header.Get("Authorization") {
+			t.Errorf("expected basic auth header, got %q", gotAuth)
+		}
+	})
+
+	t.Run("rejects token with basic auth", func(t *testing.T) {
+		_, err := NewClient(&server.URL, WithToken("s3cret"), WithBasicAuth("alice", "pw"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestWithTimeout(t *testing.T) {
+	client, err := NewClient(nil, WithTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.HTTPClient.Timeout != time.Minute {
+		t.Errorf("expected timeout to be 1m, got %v", client.HTTPClient.Timeout)
+	}
+
+	if _, err := NewClient(nil, WithTimeout(-time.Second)); err == nil {
+		t.Error("expected error for negative timeout, got nil")
+	}
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	host := "http://devops.invalid"
+
+	client, err := NewClient(&host, WithProxy(proxyURL))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	req, _ := http.NewRequest("GET", host+"/engineers", nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if proxied != "http://devops.invalid/engineers" {
+		t.Errorf("expected request to go through the proxy, got %q", proxied)
+	}
 }
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+)
+
+// ListDevOps - Returns list of DevOps groups
+func (c *Client) ListDevOps(ctx context.Context) ([]DevOps, error) {
+	return collect(c.IterDevOps(ctx))
+}
+
+// IterDevOps - Iterates over DevOps groups, fetching further pages as the loop advances
+func (c *Client) IterDevOps(ctx context.Context) iter.Seq2[DevOps, error] {
+	return paginate[DevOps](ctx, c, "/devops")
+}
+
+// GetDevOps - Returns specific DevOps group
+func (c *Client) GetDevOps(ctx context.Context, devOpsID string) (*DevOps, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/devops/id/%s", c.HostURL, devOpsID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	devOps := DevOps{}
+	err = json.Unmarshal(body, &devOps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &devOps, nil
+}
+
+// CreateDevOps - Create new DevOps group
+func (c *Client) CreateDevOps(ctx context.Context, devOps DevOps) (*DevOps, error) {
+	rb, err := json.Marshal(devOps)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/devops", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newDevOps := DevOps{}
+	err = json.Unmarshal(body, &newDevOps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newDevOps, nil
+}
+
+func (c *Client) UpdateDevOps(ctx context.Context, devOpsID string, devOps DevOps) (*DevOps, error) {
+	rb, err := json.Marshal(devOps)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/devops/%s", c.HostURL, devOpsID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DevOps
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) DeleteDevOps(ctx context.Context, devOpsID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/devops/%s", c.HostURL, devOpsID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	// Check if response contains "resource deleted"
+	if !strings.Contains(string(body), "resource deleted") {
+		return fmt.Errorf("unexpected response: resource deletion not confirmed")
+	}
+
+	return nil
+}
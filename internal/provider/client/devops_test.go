@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListDevOps(t *testing.T) {
+	groups := []DevOps{
+		{ID: "1", Devs: []Dev{{ID: "d1"}}, Ops: []Ops{{ID: "o1"}}},
+		{ID: "2", Devs: []Dev{{ID: "d2"}}, Ops: []Ops{{ID: "o2"}}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/devops" {
+			t.Errorf("expected path /devops, got %s", r.URL.Path)
+		}
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(groups)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	result, err := client.ListDevOps(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 2 {
+		t.Errorf("expected 2 devops groups, got %d", len(result))
+	}
+	if result[1].Ops[0].ID != "o2" {
+		t.Errorf("expected second group's ops ID to be o2, got %s", result[1].Ops[0].ID)
+	}
+}
+
+func TestGetDevOps(t *testing.T) {
+	group := DevOps{ID: "1", Devs: []Dev{{ID: "d1", Name: "Dev Team 1"}}, Ops: []Ops{{ID: "o1", Name: "Ops Team 1"}}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/devops/id/1" {
+			t.Errorf("expected path /devops/id/1, got %s", r.URL.Path)
+		}
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(group)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	result, err := client.GetDevOps(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.ID != "1" {
+		t.Errorf("expected devops ID to be 1, got %s", result.ID)
+	}
+	if len(result.Devs) != 1 || result.Devs[0].Name != "Dev Team 1" {
+		t.Errorf("expected one dev team named 'Dev Team 1', got %+v", result.Devs)
+	}
+}
+
+func TestCreateDevOps(t *testing.T) {
+	group := DevOps{Devs: []Dev{{ID: "d1"}}, Ops: []Ops{{ID: "o1"}}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/devops" {
+			t.Errorf("expected path /devops, got %s", r.URL.Path)
+		}
+		if r.Method != "POST" {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+
+		var received DevOps
+		json.NewDecoder(r.Body).Decode(&received)
+		if len(received.Devs) != 1 || received.Devs[0].ID != "d1" {
+			t.Errorf("expected dev d1, got %+v", received.Devs)
+		}
+
+		received.ID = "123"
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(received)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	result, err := client.CreateDevOps(context.Background(), group)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.ID != "123" {
+		t.Errorf("expected ID 123, got %s", result.ID)
+	}
+}
+
+func TestUpdateDevOps(t *testing.T) {
+	group := DevOps{Devs: []Dev{{ID: "d2"}}, Ops: []Ops{{ID: "o2"}}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/devops/1" {
+			t.Errorf("expected path /devops/1, got %s", r.URL.Path)
+		}
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT method, got %s", r.Method)
+		}
+
+		var received DevOps
+		json.NewDecoder(r.Body).Decode(&received)
+		received.ID = "1"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(received)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	result, err := client.UpdateDevOps(context.Background(), "1", group)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Ops[0].ID != "o2" {
+		t.Errorf("expected ops ID o2, got %s", result.Ops[0].ID)
+	}
+}
+
+func TestDeleteDevOps(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/devops/1" {
+			t.Errorf("expected path /devops/1, got %s", r.URL.Path)
+		}
+		if r.Method != "DELETE" {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message": "resource deleted"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := client.DeleteDevOps(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
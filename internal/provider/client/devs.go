@@ -1,36 +1,27 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
 
-// GetDevs - Returns list of Devs (no auth required)
-func (c *Client) GetDevs() ([]Dev, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dev", c.HostURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	devs := []Dev{}
-	err = json.Unmarshal(body, &devs)
-	if err != nil {
-		return nil, err
-	}
+// GetDevs - Returns list of Devs
+func (c *Client) GetDevs(ctx context.Context) ([]Dev, error) {
+	return collect(c.IterDevs(ctx))
+}
 
-	return devs, nil
+// IterDevs - Iterates over Devs, fetching further pages as the loop advances
+func (c *Client) IterDevs(ctx context.Context) iter.Seq2[Dev, error] {
+	return paginate[Dev](ctx, c, "/dev")
 }
 
-// GetDev - Returns specific Dev (no auth required)
-func (c *Client) GetDev(devID string) (*Dev, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dev/id/%s", c.HostURL, devID), nil)
+// GetDev - Returns specific Dev
+func (c *Client) GetDev(ctx context.Context, devID string) (*Dev, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/dev/id/%s", c.HostURL, devID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -50,13 +41,13 @@ func (c *Client) GetDev(devID string) (*Dev, error) {
 }
 
 // CreateDev - Create new Dev
-func (c *Client) CreateDev(dev Dev) (*Dev, error) {
+func (c *Client) CreateDev(ctx context.Context, dev Dev) (*Dev, error) {
 	rb, err := json.Marshal(dev)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/dev", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/dev", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -75,13 +66,13 @@ func (c *Client) CreateDev(dev Dev) (*Dev, error) {
 	return &newDev, nil
 }
 
-func (c *Client) UpdateDev(devID string, dev Dev) (*Dev, error) {
+func (c *Client) UpdateDev(ctx context.Context, devID string, dev Dev) (*Dev, error) {
 	rb, err := json.Marshal(dev)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/dev/%s", c.HostURL, devID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/dev/%s", c.HostURL, devID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +92,8 @@ func (c *Client) UpdateDev(devID string, dev Dev) (*Dev, error) {
 	return &resp, nil
 }
 
-func (c *Client) DeleteDev(DevID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/dev/%s", c.HostURL, DevID), nil)
+func (c *Client) DeleteDev(ctx context.Context, DevID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/dev/%s", c.HostURL, DevID), nil)
 	if err != nil {
 		return err
 	}
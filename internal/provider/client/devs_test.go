@@ -1,9 +1,11 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -30,7 +32,7 @@ func TestGetDevs(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.GetDevs()
+	result, err := client.GetDevs(context.Background())
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -67,7 +69,7 @@ func TestGetDev(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.GetDev("1")
+	result, err := client.GetDev(context.Background(), "1")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -116,7 +118,7 @@ func TestCreateDev(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.CreateDev(dev)
+	result, err := client.CreateDev(context.Background(), dev)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -159,7 +161,7 @@ func TestUpdateDev(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.UpdateDev("1", dev)
+	result, err := client.UpdateDev(context.Background(), "1", dev)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -191,7 +193,7 @@ func TestDeleteDev(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	err := client.DeleteDev("1")
+	err := client.DeleteDev(context.Background(), "1")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -209,8 +211,39 @@ func TestDeleteDevError(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	err := client.DeleteDev("1")
+	err := client.DeleteDev(context.Background(), "1")
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestDevJSONFlattensMetadata(t *testing.T) {
+	body, err := json.Marshal(Dev{
+		Name: "Dev Team 1",
+		TeamMetadata: TeamMetadata{
+			LeadEngineerID: "e1",
+			Labels:         map[string]string{"tier": "core"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, want := range []string{`"lead_engineer_id":"e1"`, `"labels":{"tier":"core"}`} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected %s at the top level, got %s", want, body)
+		}
+	}
+	for _, key := range []string{"TeamMetadata", "description", "slack_channel", "email_alias"} {
+		if strings.Contains(string(body), `"`+key+`"`) {
+			t.Errorf("expected %s to be omitted, got %s", key, body)
+		}
+	}
+
+	var dev Dev
+	if err := json.Unmarshal([]byte(`{"id":"1","description":"Builds things","email_alias":"dev@example.com"}`), &dev); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if dev.Description != "Builds things" || dev.EmailAlias != "dev@example.com" {
+		t.Errorf("expected metadata to be decoded, got %+v", dev.TeamMetadata)
+	}
+}
@@ -1,36 +1,61 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
-// GetEngineers - Returns list of engineers (no auth required)
-func (c *Client) GetEngineers() ([]Engineer, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/engineers", c.HostURL), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetEngineers - Returns list of engineers
+func (c *Client) GetEngineers(ctx context.Context) ([]Engineer, error) {
+	return collect(c.IterEngineers(ctx))
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// IterEngineers - Iterates over engineers, fetching further pages as the loop advances
+func (c *Client) IterEngineers(ctx context.Context) iter.Seq2[Engineer, error] {
+	return paginate[Engineer](ctx, c, "/engineers")
+}
 
-	engineers := []Engineer{}
-	err = json.Unmarshal(body, &engineers)
-	if err != nil {
-		return nil, err
+// EngineerFilter narrows an engineer listing. Each non-empty field is sent
+// as a repeated query parameter (id, name, email, email_domain); a server is
+// expected to return engineers matching any of a parameter's values and all
+// of the parameters. Servers that do not support filtering ignore the
+// parameters, so results may be a superset and callers must still check them.
+type EngineerFilter struct {
+	IDs          []string
+	Names        []string
+	Emails       []string
+	EmailDomains []string
+}
+
+func (f EngineerFilter) query() url.Values {
+	query := url.Values{}
+	for key, values := range map[string][]string{
+		"id":           f.IDs,
+		"name":         f.Names,
+		"email":        f.Emails,
+		"email_domain": f.EmailDomains,
+	} {
+		for _, v := range values {
+			query.Add(key, v)
+		}
 	}
 
-	return engineers, nil
+	return query
+}
+
+// ListEngineers - Returns engineers, asking the server to apply filter
+func (c *Client) ListEngineers(ctx context.Context, filter EngineerFilter) ([]Engineer, error) {
+	return collect(paginateQuery[Engineer](ctx, c, "/engineers", filter.query()))
 }
 
-// GetEngineer - Returns specific engineer (no auth required)
-func (c *Client) GetEngineer(engineerID string) (*Engineer, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/engineers/id/%s", c.HostURL, engineerID), nil)
+// GetEngineer - Returns specific engineer
+func (c *Client) GetEngineer(ctx context.Context, engineerID string) (*Engineer, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/engineers/id/%s", c.HostURL, engineerID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -50,13 +75,13 @@ func (c *Client) GetEngineer(engineerID string) (*Engineer, error) {
 }
 
 // CreateEngineer - Create new Engineer
-func (c *Client) CreateEngineer(engineer Engineer) (*Engineer, error) {
+func (c *Client) CreateEngineer(ctx context.Context, engineer Engineer) (*Engineer, error) {
 	rb, err := json.Marshal(engineer)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/engineers", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/engineers", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -75,13 +100,13 @@ func (c *Client) CreateEngineer(engineer Engineer) (*Engineer, error) {
 	return &newEngineer, nil
 }
 
-func (c *Client) UpdateEngineer(engineerID string, engineer Engineer) (*Engineer, error) {
+func (c *Client) UpdateEngineer(ctx context.Context, engineerID string, engineer Engineer) (*Engineer, error) {
 	rb, err := json.Marshal(engineer)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +126,8 @@ func (c *Client) UpdateEngineer(engineerID string, engineer Engineer) (*Engineer
 	return &resp, nil
 }
 
-func (c *Client) DeleteEngineer(engineerID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), nil)
+func (c *Client) DeleteEngineer(ctx context.Context, engineerID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), nil)
 	if err != nil {
 		return err
 	}
@@ -1,9 +1,11 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -30,7 +32,7 @@ func TestGetEngineers(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.GetEngineers()
+	result, err := client.GetEngineers(context.Background())
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -67,7 +69,7 @@ func TestGetEngineer(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.GetEngineer("1")
+	result, err := client.GetEngineer(context.Background(), "1")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -119,7 +121,7 @@ func TestCreateEngineer(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.CreateEngineer(engineer)
+	result, err := client.CreateEngineer(context.Background(), engineer)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -162,7 +164,7 @@ func TestUpdateEngineer(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.UpdateEngineer("1", engineer)
+	result, err := client.UpdateEngineer(context.Background(), "1", engineer)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -194,7 +196,7 @@ func TestDeleteEngineer(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	err := client.DeleteEngineer("1")
+	err := client.DeleteEngineer(context.Background(), "1")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -212,8 +214,86 @@ func TestDeleteEngineerError(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	err := client.DeleteEngineer("1")
+	err := client.DeleteEngineer(context.Background(), "1")
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestGetEngineerNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "engineer not found"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	_, err := client.GetEngineer(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestListEngineersFilter(t *testing.T) {
+	pages := map[string]pageEnvelope[Engineer]{
+		"":   {Items: []Engineer{{ID: "1"}}, Next: "c2"},
+		"c2": {Items: []Engineer{{ID: "2"}}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if got := strings.Join(query["id"], ","); got != "1,2" {
+			t.Errorf("expected id=1&id=2 on every page, got %q", got)
+		}
+		if got := query.Get("email_domain"); got != "example.com" {
+			t.Errorf("expected email_domain=example.com, got %q", got)
+		}
+		if query.Has("name") || query.Has("email") {
+			t.Errorf("expected empty filters to be omitted, got %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(pages[query.Get("cursor")])
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	result, err := client.ListEngineers(context.Background(), EngineerFilter{
+		IDs:          []string{"1", "2"},
+		EmailDomains: []string{"example.com"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 2 {
+		t.Errorf("expected 2 engineers, got %d", len(result))
+	}
+}
+
+func TestEngineerJSONOmitsUnsetProfile(t *testing.T) {
+	body, err := json.Marshal(Engineer{Name: "Alice", Email: "alice@example.com"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, key := range []string{"title", "timezone", "start_date", "active"} {
+		if strings.Contains(string(body), `"`+key+`"`) {
+			t.Errorf("expected %s to be omitted, got %s", key, body)
+		}
+	}
+
+	inactive := false
+	body, err = json.Marshal(Engineer{Name: "Alice", Active: &inactive})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(string(body), `"active":false`) {
+		t.Errorf("expected an explicit active=false, got %s", body)
+	}
+}
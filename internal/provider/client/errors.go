@@ -0,0 +1,108 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned by Client methods when the API answers with a status
+// other than 200 or 201.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+
+	// Message and Details are decoded from a JSON error body when the server
+	// sends one. Body always holds the raw response.
+	Message string
+	Details []ErrorDetail
+	Body    []byte
+}
+
+// ErrorDetail describes a problem with a single request field.
+type ErrorDetail struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// errorBody is the JSON shape the API uses for error responses. Older
+// endpoints send "error" instead of "message" and "errors" instead of
+// "details".
+type errorBody struct {
+	Message string        `json:"message"`
+	Error   string        `json:"error"`
+	Details []ErrorDetail `json:"details"`
+	Errors  []ErrorDetail `json:"errors"`
+}
+
+func newAPIError(req *http.Request, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Body:       body,
+	}
+
+	var eb errorBody
+	if err := json.Unmarshal(body, &eb); err == nil {
+		apiErr.Message = eb.Message
+		if apiErr.Message == "" {
+			apiErr.Message = eb.Error
+		}
+		apiErr.Details = append(eb.Details, eb.Errors...)
+	}
+
+	if apiErr.Message == "" && len(apiErr.Details) == 0 {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	for _, d := range e.Details {
+		if d.Field != "" {
+			fmt.Fprintf(&b, "; %s: %s", d.Field, d.Message)
+		} else {
+			fmt.Fprintf(&b, "; %s", d.Message)
+		}
+	}
+
+	return b.String()
+}
+
+// IsNotFound reports whether err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError with status 409.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an APIError with status 401, meaning
+// the credentials were missing or rejected.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an APIError with status 403, meaning
+// the credentials are valid but lack permission.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
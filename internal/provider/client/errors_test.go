@@ -0,0 +1,83 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Run("decodes JSON error body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "validation failed", "details": [{"field": "email", "message": "is invalid"}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			HostURL:    server.URL,
+			HTTPClient: &http.Client{},
+		}
+
+		req, _ := http.NewRequest("POST", server.URL+"/engineers", nil)
+		_, err := client.doRequest(req)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusUnprocessableEntity {
+			t.Errorf("expected status 422, got %d", apiErr.StatusCode)
+		}
+		if apiErr.Method != "POST" || apiErr.Path != "/engineers" {
+			t.Errorf("expected POST /engineers, got %s %s", apiErr.Method, apiErr.Path)
+		}
+		if apiErr.Message != "validation failed" {
+			t.Errorf("expected message 'validation failed', got %q", apiErr.Message)
+		}
+		if len(apiErr.Details) != 1 || apiErr.Details[0].Field != "email" {
+			t.Errorf("expected one detail for email, got %+v", apiErr.Details)
+		}
+
+		expected := "POST /engineers: 422 Unprocessable Entity: validation failed; email: is invalid"
+		if err.Error() != expected {
+			t.Errorf("expected %q, got %q", expected, err.Error())
+		}
+	})
+
+	t.Run("accepts legacy error key", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://example.com/dev/id/1", nil)
+		apiErr := newAPIError(req, http.StatusNotFound, []byte(`{"error": "not found"}`))
+		if apiErr.Message != "not found" {
+			t.Errorf("expected message 'not found', got %q", apiErr.Message)
+		}
+	})
+
+	t.Run("falls back to raw body", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://example.com/op", nil)
+		apiErr := newAPIError(req, http.StatusInternalServerError, []byte("upstream exploded\n"))
+		if apiErr.Message != "upstream exploded" {
+			t.Errorf("expected raw body as message, got %q", apiErr.Message)
+		}
+	})
+}
+
+func TestErrorHelpers(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	conflict := &APIError{StatusCode: http.StatusConflict}
+
+	if !IsNotFound(notFound) || IsNotFound(conflict) {
+		t.Error("IsNotFound returned the wrong result")
+	}
+	if !IsConflict(conflict) || IsConflict(notFound) {
+		t.Error("IsConflict returned the wrong result")
+	}
+	if !IsNotFound(fmt.Errorf("wrapped: %w", notFound)) {
+		t.Error("IsNotFound should see through wrapped errors")
+	}
+	if IsNotFound(errors.New("status: 404")) {
+		t.Error("IsNotFound should ignore non-API errors")
+	}
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem used for request and response logs.
+// Its level is controlled with TF_LOG_PROVIDER_DEVOPS_CLIENT.
+const LogSubsystem = "devops_client"
+
+// DefaultMaxLogBodySize caps how many bytes of a request or response body
+// are written to the trace log.
+const DefaultMaxLogBodySize = 4096
+
+// DefaultSensitiveLogFields are JSON keys whose values are always masked in
+// logged bodies.
+var DefaultSensitiveLogFields = []string{"password", "token", "secret", "api_key"}
+
+const redacted = "***"
+
+// WithSensitiveLogFields masks the values of the given JSON keys, in
+// addition to DefaultSensitiveLogFields, wherever they appear in logged
+// request and response bodies.
+func WithSensitiveLogFields(fields ...string) Option {
+	return func(c *Client) error {
+		c.SensitiveLogFields = append(c.SensitiveLogFields, fields...)
+		return nil
+	}
+}
+
+// logContext returns ctx with the client's logging subsystem attached.
+func (c *Client) logContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_DEVOPS_CLIENT"))
+}
+
+// logHeaders renders h for logging with credentials masked.
+func logHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		if strings.EqualFold(k, "Authorization") || strings.EqualFold(k, "Cookie") {
+			out[k] = redacted
+			continue
+		}
+		out[k] = strings.Join(v, ", ")
+	}
+
+	return out
+}
+
+// logBody renders body for logging. Values of sensitive JSON keys are masked
+// and the result is truncated to MaxLogBodySize bytes.
+func (c *Client) logBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	body = redactJSON(body, c.SensitiveLogFields)
+
+	limit := c.MaxLogBodySize
+	if limit <= 0 {
+		limit = DefaultMaxLogBodySize
+	}
+
+	if len(body) > limit {
+		return fmt.Sprintf("%s... (%d bytes truncated)", body[:limit], len(body)-limit)
+	}
+
+	return string(body)
+}
+
+// redactJSON masks the values of fields, matched case-insensitively at any
+// depth, in a JSON document. Bodies that are not JSON are returned as is.
+func redactJSON(body []byte, fields []string) []byte {
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return body
+	}
+
+	sensitive := make(map[string]struct{}, len(fields)+len(DefaultSensitiveLogFields))
+	for _, list := range [][]string{DefaultSensitiveLogFields, fields} {
+		for _, f := range list {
+			sensitive[strings.ToLower(f)] = struct{}{}
+		}
+	}
+
+	var walk func(v any) any
+	walk = func(v any) any {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, child := range v {
+				if _, ok := sensitive[strings.ToLower(k)]; ok {
+					v[k] = redacted
+					continue
+				}
+				v[k] = walk(child)
+			}
+		case []any:
+			for i, child := range v {
+				v[i] = walk(child)
+			}
+		}
+		return v
+	}
+
+	out, err := json.Marshal(walk(doc))
+	if err != nil {
+		return body
+	}
+
+	return out
+}
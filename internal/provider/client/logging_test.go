@@ -0,0 +1,82 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestDoRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "1", "api_key": "returned-secret"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, WithToken("s3cret"), WithSensitiveLogFields("ssn"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, "POST", server.URL+"/engineers", strings.NewReader(`{"name": "Alice", "password": "hunter2", "ssn": "123"}`))
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	logged := output.String()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decoding log output: %v", err)
+	}
+
+	var sawResponse bool
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+LogSubsystem {
+			t.Errorf("expected entry in %s subsystem, got %v", LogSubsystem, entry["@module"])
+		}
+		if entry["@message"] == "Received API response" {
+			sawResponse = true
+			if entry["status"] != float64(http.StatusCreated) {
+				t.Errorf("expected status 201, got %v", entry["status"])
+			}
+			if entry["method"] != "POST" {
+				t.Errorf("expected method POST, got %v", entry["method"])
+			}
+		}
+	}
+	if !sawResponse {
+		t.Error("expected a response log entry")
+	}
+
+	for _, secret := range []string{"s3cret", "hunter2", "returned-secret", `"123"`} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log output contains unmasked secret %q", secret)
+		}
+	}
+	if !strings.Contains(logged, "Alice") {
+		t.Error("expected non-sensitive body fields to be logged")
+	}
+}
+
+func TestLogBody(t *testing.T) {
+	client := &Client{MaxLogBodySize: 8}
+
+	got := client.logBody([]byte("0123456789abcdef"))
+	if got != "01234567... (8 bytes truncated)" {
+		t.Errorf("unexpected truncated body %q", got)
+	}
+
+	got = (&Client{}).logBody([]byte(`{"Token": "x"}`))
+	if got != `{"Token":"***"}` {
+		t.Errorf("expected case-insensitive masking, got %q", got)
+	}
+}
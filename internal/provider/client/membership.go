@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// The API has no endpoint for a single team membership, so the helpers below
+// read the team, change only the one engineer, and write the team back.
+// Terraform applies resources in parallel, so each helper holds the team's
+// lock across the read and the write; otherwise concurrent changes to one
+// team would overwrite each other.
+
+// lockTeam locks the team of the given kind and ID and returns the unlock
+// function.
+func (c *Client) lockTeam(kind, teamID string) func() {
+	mu, _ := c.teamLocks.LoadOrStore(kind+"/"+teamID, &sync.Mutex{})
+	lock, _ := mu.(*sync.Mutex)
+	lock.Lock()
+	return lock.Unlock
+}
+
+// AddDevEngineer - Adds an engineer to a Dev, leaving other members untouched
+func (c *Client) AddDevEngineer(ctx context.Context, devID, engineerID string) error {
+	defer c.lockTeam("dev", devID)()
+
+	dev, err := c.GetDev(ctx, devID)
+	if err != nil {
+		return err
+	}
+
+	if hasEngineer(dev.Engineers, engineerID) {
+		return nil
+	}
+
+	dev.Engineers = append(dev.Engineers, Engineer{ID: engineerID})
+
+	_, err = c.UpdateDev(ctx, devID, *dev)
+	return err
+}
+
+// RemoveDevEngineer - Removes an engineer from a Dev, leaving other members untouched
+func (c *Client) RemoveDevEngineer(ctx context.Context, devID, engineerID string) error {
+	defer c.lockTeam("dev", devID)()
+
+	dev, err := c.GetDev(ctx, devID)
+	if err != nil {
+		return err
+	}
+
+	if !hasEngineer(dev.Engineers, engineerID) {
+		return nil
+	}
+
+	dev.Engineers = withoutEngineer(dev.Engineers, engineerID)
+	// The lead must be a member, so removing the lead leaves the team
+	// without one. The empty ID is omitted, which clears it on PUT.
+	if dev.LeadEngineerID == engineerID {
+		dev.LeadEngineerID = ""
+	}
+
+	_, err = c.UpdateDev(ctx, devID, *dev)
+	return err
+}
+
+// AddOpsEngineer - Adds an engineer to an Ops team, leaving other members untouched
+func (c *Client) AddOpsEngineer(ctx context.Context, opsID, engineerID string) error {
+	defer c.lockTeam("ops", opsID)()
+
+	ops, err := c.GetOp(ctx, opsID)
+	if err != nil {
+		return err
+	}
+
+	if hasEngineer(ops.Engineers, engineerID) {
+		return nil
+	}
+
+	ops.Engineers = append(ops.Engineers, Engineer{ID: engineerID})
+
+	_, err = c.UpdateOps(ctx, opsID, *ops)
+	return err
+}
+
+// RemoveOpsEngineer - Removes an engineer from an Ops team, leaving other members untouched
+func (c *Client) RemoveOpsEngineer(ctx context.Context, opsID, engineerID string) error {
+	defer c.lockTeam("ops", opsID)()
+
+	ops, err := c.GetOp(ctx, opsID)
+	if err != nil {
+		return err
+	}
+
+	if !hasEngineer(ops.Engineers, engineerID) {
+		return nil
+	}
+
+	ops.Engineers = withoutEngineer(ops.Engineers, engineerID)
+	// The lead must be a member, so removing the lead leaves the team
+	// without one. The empty ID is omitted, which clears it on PUT.
+	if ops.LeadEngineerID == engineerID {
+		ops.LeadEngineerID = ""
+	}
+
+	_, err = c.UpdateOps(ctx, opsID, *ops)
+	return err
+}
+
+func hasEngineer(engineers []Engineer, engineerID string) bool {
+	return slices.ContainsFunc(engineers, func(e Engineer) bool { return e.ID == engineerID })
+}
+
+func withoutEngineer(engineers []Engineer, engineerID string) []Engineer {
+	return slices.DeleteFunc(slices.Clone(engineers), func(e Engineer) bool { return e.ID == engineerID })
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTeamServer serves GET and PUT for a single team under prefix, counting
+// the writes it receives.
+func newTeamServer(t *testing.T, prefix string, members []Engineer, puts *int) (*httptest.Server, *[]Engineer) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == prefix+"/id/t1":
+			json.NewEncoder(w).Encode(Dev{ID: "t1", Name: "Team", Engineers: members})
+		case r.Method == "PUT" && r.URL.Path == prefix+"/t1":
+			*puts++
+			var team Dev
+			json.NewDecoder(r.Body).Decode(&team)
+			members = team.Engineers
+			json.NewEncoder(w).Encode(team)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &members
+}
+
+func engineerIDs(engineers []Engineer) string {
+	ids := make([]string, len(engineers))
+	for i, e := range engineers {
+		ids[i] = e.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestAddDevEngineer(t *testing.T) {
+	var puts int
+	server, members := newTeamServer(t, "/dev", []Engineer{{ID: "e1"}}, &puts)
+	client := &Client{HostURL: server.URL, HTTPClient: &http.Client{}}
+
+	if err := client.AddDevEngineer(context.Background(), "t1", "e2"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := engineerIDs(*members); got != "e1,e2" {
+		t.Errorf("expected members e1,e2, got %s", got)
+	}
+
+	if err := client.AddDevEngineer(context.Background(), "t1", "e2"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if puts != 1 {
+		t.Errorf("expected adding an existing member to skip the update, got %d updates", puts)
+	}
+}
+
+func TestRemoveDevEngineer(t *testing.T) {
+	var puts int
+	server, members := newTeamServer(t, "/dev", []Engineer{{ID: "e1"}, {ID: "e2"}, {ID: "e3"}}, &puts)
+	client := &Client{HostURL: server.URL, HTTPClient: &http.Client{}}
+
+	if err := client.RemoveDevEngineer(context.Background(), "t1", "e2"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := engineerIDs(*members); got != "e1,e3" {
+		t.Errorf("expected members e1,e3, got %s", got)
+	}
+
+	if err := client.RemoveDevEngineer(context.Background(), "t1", "e2"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if puts != 1 {
+		t.Errorf("expected removing a non-member to skip the update, got %d updates", puts)
+	}
+}
+
+func TestOpsEngineerMembership(t *testing.T) {
+	var puts int
+	server, members := newTeamServer(t, "/op", []Engineer{{ID: "e1"}}, &puts)
+	client := &Client{HostURL: server.URL, HTTPClient: &http.Client{}}
+
+	if err := client.AddOpsEngineer(context.Background(), "t1", "e2"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := client.RemoveOpsEngineer(context.Background(), "t1", "e1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := engineerIDs(*members); got != "e2" {
+		t.Errorf("expected members e2, got %s", got)
+	}
+}
+
+func TestAddDevEngineer_TeamNotFound(t *testing.T) {
+	var puts int
+	server, _ := newTeamServer(t, "/dev", nil, &puts)
+	client := &Client{HostURL: server.URL, HTTPClient: &http.Client{}}
+
+	err := client.AddDevEngineer(context.Background(), "missing", "e1")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestTeamMembershipConcurrent(t *testing.T) {
+	for _, tc := range []struct {
+		prefix string
+		add    func(c *Client, ctx context.Context, teamID, engineerID string) error
+	}{
+		{prefix: "/dev", add: (*Client).AddDevEngineer},
+		{prefix: "/op", add: (*Client).AddOpsEngineer},
+	} {
+		t.Run(tc.prefix, func(t *testing.T) {
+			var mu sync.Mutex
+			var members []Engineer
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && r.URL.Path == tc.prefix+"/id/t1":
+					mu.Lock()
+					team := Dev{ID: "t1", Name: "Team", Engineers: members}
+					mu.Unlock()
+					// Widen the window between read and write so
+					// unserialized updates would be lost.
+					time.Sleep(10 * time.Millisecond)
+					json.NewEncoder(w).Encode(team)
+				case r.Method == "PUT" && r.URL.Path == tc.prefix+"/t1":
+					var team Dev
+					json.NewDecoder(r.Body).Decode(&team)
+					mu.Lock()
+					members = team.Engineers
+					mu.Unlock()
+					json.NewEncoder(w).Encode(team)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := &Client{HostURL: server.URL, HTTPClient: &http.Client{}}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 4)
+			for _, id := range []string{"e1", "e2", "e3", "e4"} {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					errs <- tc.add(client, context.Background(), "t1", id)
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+			if len(members) != 4 {
+				t.Errorf("expected all 4 concurrent adds to be kept, got %s", engineerIDs(members))
+			}
+		})
+	}
+}
+
+func TestRemoveTeamLead(t *testing.T) {
+	for _, tc := range []struct {
+		prefix string
+		remove func(c *Client, ctx context.Context, teamID, engineerID string) error
+	}{
+		{prefix: "/dev", remove: (*Client).RemoveDevEngineer},
+		{prefix: "/op", remove: (*Client).RemoveOpsEngineer},
+	} {
+		t.Run(tc.prefix, func(t *testing.T) {
+			team := Dev{
+				ID:           "t1",
+				Name:         "Team",
+				Engineers:    []Engineer{{ID: "e1"}, {ID: "e2"}},
+				TeamMetadata: TeamMetadata{LeadEngineerID: "e1", Description: "Builds things"},
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && r.URL.Path == tc.prefix+"/id/t1":
+					json.NewEncoder(w).Encode(team)
+				case r.Method == "PUT" && r.URL.Path == tc.prefix+"/t1":
+					team = Dev{}
+					json.NewDecoder(r.Body).Decode(&team)
+					json.NewEncoder(w).Encode(team)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := &Client{HostURL: server.URL, HTTPClient: &http.Client{}}
+
+			if err := tc.remove(client, context.Background(), "t1", "e2"); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if team.LeadEngineerID != "e1" {
+				t.Errorf("expected removing another member to keep lead e1, got %q", team.LeadEngineerID)
+			}
+
+			if err := tc.remove(client, context.Background(), "t1", "e1"); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if team.LeadEngineerID != "" || len(team.Engineers) != 0 {
+				t.Errorf("expected removing the lead to clear it, got lead %q and members %s", team.LeadEngineerID, engineerIDs(team.Engineers))
+			}
+			if team.Description != "Builds things" {
+				t.Errorf("expected other metadata to be kept, got %+v", team.TeamMetadata)
+			}
+		})
+	}
+}
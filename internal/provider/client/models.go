@@ -4,18 +4,45 @@ type Engineer struct {
 	ID    string `json:"id"`
 	Name  string `json:"name"`
 	Email string `json:"email"`
+
+	// Profile fields are optional; empty values are left out of requests.
+	Title          string `json:"title,omitempty"`
+	Seniority      string `json:"seniority,omitempty"`
+	GithubUsername string `json:"github_username,omitempty"`
+	SlackHandle    string `json:"slack_handle,omitempty"`
+	ManagerID      string `json:"manager_id,omitempty"`
+	Location       string `json:"location,omitempty"`
+	// Timezone is an IANA zone name such as "Europe/Berlin".
+	Timezone string `json:"timezone,omitempty"`
+	// StartDate is an RFC 3339 full-date such as "2024-03-01".
+	StartDate string `json:"start_date,omitempty"`
+	// Active is nil when unknown, so an unset flag is not sent as false.
+	Active *bool `json:"active,omitempty"`
 }
 
 type Dev struct {
 	ID        string     `json:"id"`
 	Name      string     `json:"name"`
 	Engineers []Engineer `json:"engineers"`
+	TeamMetadata
 }
 
 type Ops struct {
 	ID        string     `json:"id"`
 	Name      string     `json:"name"`
 	Engineers []Engineer `json:"engineers"`
+	TeamMetadata
+}
+
+// TeamMetadata holds the descriptive fields shared by dev and ops teams.
+// Empty fields are left out of requests.
+type TeamMetadata struct {
+	Description string `json:"description,omitempty"`
+	// LeadEngineerID must be one of the team's engineers.
+	LeadEngineerID string            `json:"lead_engineer_id,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	SlackChannel   string            `json:"slack_channel,omitempty"`
+	EmailAlias     string            `json:"email_alias,omitempty"`
 }
 
 type DevOps struct {
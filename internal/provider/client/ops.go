@@ -1,36 +1,27 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
 
-// Getops - Returns list of ops (no auth required)
-func (c *Client) GetOps() ([]Ops, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/op", c.HostURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	ops := []Ops{}
-	err = json.Unmarshal(body, &ops)
-	if err != nil {
-		return nil, err
-	}
+// Getops - Returns list of ops
+func (c *Client) GetOps(ctx context.Context) ([]Ops, error) {
+	return collect(c.IterOps(ctx))
+}
 
-	return ops, nil
+// IterOps - Iterates over ops, fetching further pages as the loop advances
+func (c *Client) IterOps(ctx context.Context) iter.Seq2[Ops, error] {
+	return paginate[Ops](ctx, c, "/op")
 }
 
-// Getop - Returns specific op (no auth required)
-func (c *Client) GetOp(opID string) (*Ops, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/op/id/%s", c.HostURL, opID), nil)
+// Getop - Returns specific op
+func (c *Client) GetOp(ctx context.Context, opID string) (*Ops, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/op/id/%s", c.HostURL, opID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -50,13 +41,13 @@ func (c *Client) GetOp(opID string) (*Ops, error) {
 }
 
 // Createop - Create new op
-func (c *Client) CreateOps(op Ops) (*Ops, error) {
+func (c *Client) CreateOps(ctx context.Context, op Ops) (*Ops, error) {
 	rb, err := json.Marshal(op)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/op", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/op", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -75,13 +66,13 @@ func (c *Client) CreateOps(op Ops) (*Ops, error) {
 	return &newop, nil
 }
 
-func (c *Client) UpdateOps(opID string, op Ops) (*Ops, error) {
+func (c *Client) UpdateOps(ctx context.Context, opID string, op Ops) (*Ops, error) {
 	rb, err := json.Marshal(op)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/op/%s", c.HostURL, opID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/op/%s", c.HostURL, opID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +92,8 @@ func (c *Client) UpdateOps(opID string, op Ops) (*Ops, error) {
 	return &resp, nil
 }
 
-func (c *Client) DeleteOps(opID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/op/%s", c.HostURL, opID), nil)
+func (c *Client) DeleteOps(ctx context.Context, opID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/op/%s", c.HostURL, opID), nil)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -30,7 +31,7 @@ func TestGetOps(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.GetOps()
+	result, err := client.GetOps(context.Background())
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -67,7 +68,7 @@ func TestGetOp(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.GetOp("1")
+	result, err := client.GetOp(context.Background(), "1")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -116,7 +117,7 @@ func TestCreateOps(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.CreateOps(op)
+	result, err := client.CreateOps(context.Background(), op)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -159,7 +160,7 @@ func TestUpdateOps(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	result, err := client.UpdateOps("1", op)
+	result, err := client.UpdateOps(context.Background(), "1", op)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -191,7 +192,7 @@ func TestDeleteOps(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	err := client.DeleteOps("1")
+	err := client.DeleteOps(context.Background(), "1")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -209,7 +210,7 @@ func TestDeleteOpsError(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	err := client.DeleteOps("1")
+	err := client.DeleteOps(context.Background(), "1")
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
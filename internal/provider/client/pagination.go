@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// List endpoints may answer with a bare JSON array holding the whole
+// collection, or with a page envelope:
+//
+//	{"items": [...], "next": "<cursor>"}            cursor-based
+//	{"items": [...], "page": 1, "total_pages": 3}   page/limit
+//
+// Cursor pages are followed with ?cursor=<next>. Page/limit pages are
+// followed with ?page=<n>; when the server omits total_pages, paging stops
+// at the first empty page or the first page shorter than the page size.
+// PageSize is sent as ?limit=<n>; when it is unset, the length of the first
+// page is taken as the server's page size.
+type pageEnvelope[T any] struct {
+	Items      []T    `json:"items"`
+	Next       string `json:"next"`
+	Page       int    `json:"page"`
+	TotalPages int    `json:"total_pages"`
+}
+
+// paginate yields every item of the collection at path, fetching pages on
+// demand. Iteration stops at the first error, which is yielded with a zero
+// item.
+func paginate[T any](ctx context.Context, c *Client, path string) iter.Seq2[T, error] {
+	return paginateQuery[T](ctx, c, path, nil)
+}
+
+// paginateQuery is paginate with extra query parameters sent on every page
+// request.
+func paginateQuery[T any](ctx context.Context, c *Client, path string, params url.Values) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		query := url.Values{}
+		for key, values := range params {
+			query[key] = append([]string(nil), values...)
+		}
+		if c.PageSize > 0 {
+			query.Set("limit", strconv.Itoa(c.PageSize))
+		}
+
+		// pageSize is the expected length of a full page/limit page, and
+		// lastPage the last page number seen.
+		pageSize, lastPage := c.PageSize, 0
+
+		for {
+			u := c.HostURL + path
+			if len(query) > 0 {
+				u += "?" + query.Encode()
+			}
+
+			req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			body, err := c.doRequest(req)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := decodePage[T](body)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			switch {
+			case page.Next != "":
+				if page.Next == query.Get("cursor") {
+					yield(zero, fmt.Errorf("GET %s: server returned the same cursor twice", path))
+					return
+				}
+				query.Set("cursor", page.Next)
+			case page.Page > 0 && page.Page <= lastPage:
+				yield(zero, fmt.Errorf("GET %s: server returned page %d again", path, page.Page))
+				return
+			case page.Page > 0 && page.TotalPages > 0:
+				if page.TotalPages <= page.Page {
+					return
+				}
+				lastPage = page.Page
+				query.Set("page", strconv.Itoa(page.Page+1))
+			case page.Page > 0:
+				if pageSize == 0 {
+					pageSize = len(page.Items)
+				}
+				if len(page.Items) == 0 || len(page.Items) < pageSize {
+					return
+				}
+				lastPage = page.Page
+				query.Set("page", strconv.Itoa(page.Page+1))
+			default:
+				return
+			}
+		}
+	}
+}
+
+// decodePage accepts either a bare array or a page envelope.
+func decodePage[T any](body []byte) (pageEnvelope[T], error) {
+	var page pageEnvelope[T]
+
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err := json.Unmarshal(trimmed, &page.Items)
+		return page, err
+	}
+
+	err := json.Unmarshal(body, &page)
+	return page, err
+}
+
+// collect drains seq into a slice.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
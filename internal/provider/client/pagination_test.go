@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestGetEngineersCursorPagination(t *testing.T) {
+	pages := map[string]pageEnvelope[Engineer]{
+		"":   {Items: []Engineer{{ID: "1"}, {ID: "2"}}, Next: "c2"},
+		"c2": {Items: []Engineer{{ID: "3"}, {ID: "4"}}, Next: "c3"},
+		"c3": {Items: []Engineer{{ID: "5"}}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("limit") != "2" {
+			t.Errorf("expected limit=2, got %q", r.URL.Query().Get("limit"))
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(pages[r.URL.Query().Get("cursor")])
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+		PageSize:   2,
+	}
+
+	result, err := client.GetEngineers(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 5 {
+		t.Fatalf("expected 5 engineers, got %d", len(result))
+	}
+	if result[4].ID != "5" {
+		t.Errorf("expected last engineer ID to be 5, got %s", result[4].ID)
+	}
+}
+
+func TestGetDevsPagePagination(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(pageEnvelope[Dev]{
+			Items:      []Dev{{ID: strconv.Itoa(page)}},
+			Page:       page,
+			TotalPages: 3,
+		})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	result, err := client.GetDevs(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 3 || requests != 3 {
+		t.Fatalf("expected 3 devs over 3 requests, got %d over %d", len(result), requests)
+	}
+}
+
+func TestGetOpsPageWithoutTotals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		items := []Ops{{ID: "a"}, {ID: "b"}}
+		if page == 2 {
+			items = items[:1]
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(pageEnvelope[Ops]{Items: items, Page: page})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+		PageSize:   2,
+	}
+
+	result, err := client.GetOps(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 3 {
+		t.Fatalf("expected 3 ops, stopping at the short page, got %d", len(result))
+	}
+}
+
+func TestGetOpsPageWithoutTotalsOrLimit(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pages [][]Ops
+		want  int
+	}{
+		"short last page": {
+			pages: [][]Ops{{{ID: "a"}, {ID: "b"}, {ID: "c"}}, {{ID: "d"}, {ID: "e"}, {ID: "f"}}, {{ID: "g"}}},
+			want:  7,
+		},
+		"empty last page": {
+			pages: [][]Ops{{{ID: "a"}, {ID: "b"}}, {{ID: "c"}, {ID: "d"}}, {}},
+			want:  4,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Has("limit") {
+					t.Errorf("expected no limit, got %q", r.URL.Query().Get("limit"))
+				}
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				if page == 0 {
+					page = 1
+				}
+
+				var items []Ops
+				if page <= len(tc.pages) {
+					items = tc.pages[page-1]
+				}
+
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(pageEnvelope[Ops]{Items: items, Page: page})
+			}))
+			defer server.Close()
+
+			client := &Client{
+				HostURL:    server.URL,
+				HTTPClient: &http.Client{},
+			}
+
+			result, err := client.GetOps(context.Background())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(result) != tc.want {
+				t.Fatalf("expected %d ops, got %d", tc.want, len(result))
+			}
+		})
+	}
+}
+
+func TestPaginationRepeatedPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(pageEnvelope[Engineer]{Items: []Engineer{{ID: "x"}}, Page: 1})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	if _, err := client.GetEngineers(context.Background()); err == nil {
+		t.Fatal("expected error for a server that ignores the page parameter, got nil")
+	}
+}
+
+func TestIterEngineersStopsEarly(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(pageEnvelope[Engineer]{
+			Items: []Engineer{{ID: "x"}},
+			Next:  "page-" + strconv.Itoa(requests),
+		})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	seen := 0
+	for _, err := range client.IterEngineers(context.Background()) {
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		seen++
+		if seen == 2 {
+			break
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestPaginationRepeatedCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(pageEnvelope[Engineer]{Items: []Engineer{{ID: "x"}}, Next: "same"})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	if _, err := client.GetEngineers(context.Background()); err == nil {
+		t.Fatal("expected error for a repeated cursor, got nil")
+	}
+}
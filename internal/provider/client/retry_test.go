@@ -0,0 +1,181 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(url string) *Client {
+	return &Client{
+		HostURL:      url,
+		HTTPClient:   &http.Client{},
+		MaxRetries:   3,
+		RetryWaitMin: time.Millisecond,
+		RetryMaxWait: 10 * time.Millisecond,
+	}
+}
+
+func TestDoRequestRetries(t *testing.T) {
+	t.Run("retries GET on 503 until success", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`ok`))
+		}))
+		defer server.Close()
+
+		client := newRetryTestClient(server.URL)
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		body, err := client.doRequest(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if string(body) != "ok" {
+			t.Errorf("expected body ok, got %s", body)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("gives up after MaxRetries", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := newRetryTestClient(server.URL)
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		_, err := client.doRequest(req)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if calls != 4 {
+			t.Errorf("expected 4 calls, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry POST on 502", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := newRetryTestClient(server.URL)
+
+		req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+		_, err := client.doRequest(req)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("retries POST on 429 and replays the body", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			b, _ := io.ReadAll(r.Body)
+			if string(b) != `{"name":"x"}` {
+				t.Errorf("unexpected body on call %d: %s", calls, b)
+			}
+			if calls == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client := newRetryTestClient(server.URL)
+
+		req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"name":"x"}`))
+		_, err := client.doRequest(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		client := newRetryTestClient(server.URL)
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		client.doRequest(req)
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	client := &Client{RetryWaitMin: 100 * time.Millisecond, RetryMaxWait: time.Second}
+
+	t.Run("grows exponentially and is capped", func(t *testing.T) {
+		for attempt, upper := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+			upper *= time.Millisecond
+			wait := client.backoff(attempt, nil)
+			if wait < upper/2 || wait > upper {
+				t.Errorf("attempt %d: expected wait in [%v, %v], got %v", attempt, upper/2, upper, wait)
+			}
+		}
+	})
+
+	t.Run("honors Retry-After seconds", func(t *testing.T) {
+		res := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+		res.Header.Set("Retry-After", "0")
+		if wait := client.backoff(0, res); wait != 0 {
+			t.Errorf("expected 0, got %v", wait)
+		}
+	})
+
+	t.Run("caps Retry-After at RetryMaxWait", func(t *testing.T) {
+		res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		res.Header.Set("Retry-After", "120")
+		if wait := client.backoff(0, res); wait != time.Second {
+			t.Errorf("expected 1s, got %v", wait)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("5"); !ok || d != 5*time.Second {
+		t.Errorf("expected 5s, got %v (ok=%v)", d, ok)
+	}
+
+	future := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(future); !ok || d <= 0 {
+		t.Errorf("expected positive duration for HTTP date, got %v (ok=%v)", d, ok)
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected invalid value to be rejected")
+	}
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TLSConfig holds the PEM-encoded material used to build the client's TLS
+// settings. Empty fields leave the corresponding default in place.
+type TLSConfig struct {
+	// CACertPEM replaces the system roots used to verify the server.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM enable mutual TLS. Both must be set.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables server certificate verification.
+	InsecureSkipVerify bool
+}
+
+// WithTLS configures the transport's TLS settings from cfg.
+func WithTLS(cfg TLSConfig) Option {
+	return func(c *Client) error {
+		tlsConfig, err := cfg.build()
+		if err != nil {
+			return err
+		}
+
+		c.transport().TLSClientConfig = tlsConfig
+		return nil
+	}
+}
+
+func (cfg TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if len(cfg.CACertPEM) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CACertPEM) {
+			return nil, errors.New("CA certificate: no PEM-encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (len(cfg.ClientCertPEM) > 0) != (len(cfg.ClientKeyPEM) > 0) {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+
+	if len(cfg.ClientCertPEM) > 0 {
+		cert, err := tls.X509KeyPair(cfg.ClientCertPEM, cfg.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// transport returns the *http.Transport used by the client, installing a
+// copy of the default transport if none is set yet.
+func (c *Client) transport() *http.Transport {
+	if t, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+		return t
+	}
+
+	t := newTransport()
+	c.HTTPClient.Transport = t
+	return t
+}
+
+// newTransport returns a copy of http.DefaultTransport so per-client settings
+// do not leak into other users of the default.
+func newTransport() *http.Transport {
+	if t, ok := http.DefaultTransport.(*http.Transport); ok {
+		return t.Clone()
+	}
+
+	return &http.Transport{Proxy: http.ProxyFromEnvironment}
+}
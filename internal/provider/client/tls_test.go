@@ -0,0 +1,138 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func serverCAPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func newTestClientCert(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestWithTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("fails without the server CA", func(t *testing.T) {
+		client, err := NewClient(&server.URL)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		client.MaxRetries = 0
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		if _, err := client.doRequest(req); err == nil {
+			t.Fatal("expected certificate error, got nil")
+		}
+	})
+
+	t.Run("trusts a custom CA", func(t *testing.T) {
+		client, err := NewClient(&server.URL, WithTLS(TLSConfig{CACertPEM: serverCAPEM(server)}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("skips verification when insecure", func(t *testing.T) {
+		client, err := NewClient(&server.URL, WithTLS(TLSConfig{InsecureSkipVerify: true}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("rejects invalid CA PEM", func(t *testing.T) {
+		_, err := NewClient(&server.URL, WithTLS(TLSConfig{CACertPEM: []byte("not a certificate")}))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects a client certificate without a key", func(t *testing.T) {
+		certPEM, _ := newTestClientCert(t)
+		_, err := NewClient(&server.URL, WithTLS(TLSConfig{ClientCertPEM: certPEM}))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestWithTLSClientCertificate(t *testing.T) {
+	certPEM, keyPEM := newTestClientCert(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, WithTLS(TLSConfig{
+		CACertPEM:     serverCAPEM(server),
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
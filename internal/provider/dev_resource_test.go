@@ -4,14 +4,19 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"terraform-provider-devops/internal/provider/client"
 )
 
 func TestDevResource_Schema(t *testing.T) {
+	engineers := []client.Engineer{{ID: "e2"}, {ID: "e1"}}
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case r.Method == "POST" && r.URL.Path == "/dev":
@@ -24,10 +29,13 @@ func TestDevResource_Schema(t *testing.T) {
 			dev := client.Dev{
 				ID:        "test-id-1",
 				Name:      "Dev Team Alpha",
-				Engineers: []client.Engineer{{ID: "e1"}, {ID: "e2"}},
+				Engineers: engineers,
 			}
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(dev)
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testTeamEngineers)
 		case r.Method == "DELETE":
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"message": "resource deleted"}`))
@@ -46,11 +54,40 @@ func TestDevResource_Schema(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("devops_dev.test", "name", "Dev Team Alpha"),
 					resource.TestCheckResourceAttr("devops_dev.test", "engineers.#", "2"),
-					resource.TestCheckResourceAttr("devops_dev.test", "engineers.0", "e1"),
-					resource.TestCheckResourceAttr("devops_dev.test", "engineers.1", "e2"),
+					resource.TestCheckTypeSetElemAttr("devops_dev.test", "engineers.*", "e1"),
+					resource.TestCheckTypeSetElemAttr("devops_dev.test", "engineers.*", "e2"),
 					resource.TestCheckResourceAttrSet("devops_dev.test", "id"),
 				),
 			},
+			{
+				ResourceName:      "devops_dev.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// force_destroy is provider-side only, so import takes the
+				// default rather than the configured true.
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				// Import against a config that leaves force_destroy at its
+				// default, so the import plan is a no-op. The team is
+				// emptied first so destroy succeeds without force_destroy.
+				PreConfig: func() {
+					engineers = nil
+				},
+				Config: `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_dev" "test" {
+  name      = "Dev Team Alpha"
+  engineers = []
+}
+`,
+				ResourceName:    "devops_dev.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 		},
 	})
 }
@@ -77,6 +114,9 @@ func TestDevResource_Update(t *testing.T) {
 			currentDev.ID = "test-id-1"
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(currentDev)
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testTeamEngineers)
 		case r.Method == "DELETE":
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"message": "resource deleted"}`))
@@ -117,8 +157,282 @@ provider "devops" {
 }
 
 resource "devops_dev" "test" {
-  name      = "` + name + `"
-  engineers = ` + string(engineersJSON) + `
+  name          = "` + name + `"
+  engineers     = ` + string(engineersJSON) + `
+  force_destroy = true
+}
+`
+}
+
+// testTeamEngineers are the engineers the team resource mocks know about.
+var testTeamEngineers = []client.Engineer{
+	{ID: "e1", Name: "Alice", Email: "alice@example.com"},
+	{ID: "e2", Name: "Bob", Email: "bob@example.com"},
+	{ID: "e3", Name: "Carol", Email: "carol@example.com"},
+	{ID: "e4", Name: "Dave", Email: "dave@example.com"},
+}
+
+func TestDevResource_UnknownEngineer(t *testing.T) {
+	var current client.Dev
+	engineers := slices.Clone(testTeamEngineers)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(engineers)
+		case r.Method == "POST" && r.URL.Path == "/engineers":
+			var engineer client.Engineer
+			json.NewDecoder(r.Body).Decode(&engineer)
+			engineer.ID = "e5"
+			engineers = append(engineers, engineer)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(engineer)
+		case r.Method == "GET" && r.URL.Path == "/engineers/id/e5":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(engineers[len(engineers)-1])
+		case r.Method == "POST" && r.URL.Path == "/dev":
+			json.NewDecoder(r.Body).Decode(&current)
+			current.ID = "test-id-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/dev/id/"):
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && (r.URL.Path == "/dev" || r.URL.Path == "/op"):
+			// No teams by the time the engineer is destroyed.
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDevResourceConfigWithHost(server.URL, "Dev Team Alpha", []string{"e1", "e9"}),
+				ExpectError: regexp.MustCompile(`No engineer has ID "e9"`),
+			},
+			{
+				// The new engineer's ID is unknown at plan time, so only e1
+				// is checked.
+				Config: `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_engineer" "new" {
+  name  = "Erin"
+  email = "erin@example.com"
+}
+
+resource "devops_dev" "test" {
+  name          = "Dev Team Alpha"
+  engineers     = ["e1", devops_engineer.new.id]
+  force_destroy = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_dev.test", "engineers.#", "2"),
+					resource.TestCheckTypeSetElemAttr("devops_dev.test", "engineers.*", "e5"),
+				),
+			},
+		},
+	})
+}
+
+func TestDevResource_ForceDestroy(t *testing.T) {
+	var current client.Dev
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testTeamEngineers)
+		case r.Method == "POST" && r.URL.Path == "/dev":
+			json.NewDecoder(r.Body).Decode(&current)
+			current.ID = "test-id-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && r.URL.Path == "/dev/id/test-id-1" && !deleted:
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "PUT" && r.URL.Path == "/dev/test-id-1":
+			json.NewDecoder(r.Body).Decode(&current)
+			current.ID = "test-id-1"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "DELETE" && r.URL.Path == "/dev/test-id-1":
+			deleted = true
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	providerOnly := `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerOnly + `
+resource "devops_dev" "test" {
+  name      = "Dev Team Alpha"
+  engineers = []
+}
+`,
+				Check: resource.TestCheckResourceAttr("devops_dev.test", "force_destroy", "false"),
+			},
+			{
+				// Bob joins outside Terraform, so the team is not empty
+				// even though the configuration listed no engineers.
+				PreConfig: func() {
+					current.Engineers = append(current.Engineers, client.Engineer{ID: "e2", Name: "Bob"})
+				},
+				Config:      providerOnly,
+				ExpectError: regexp.MustCompile(`still has 1 member\(s\): e2 \(Bob\)`),
+			},
+			{
+				Config: providerOnly + `
+resource "devops_dev" "test" {
+  name          = "Dev Team Alpha"
+  engineers     = ["e2"]
+  force_destroy = true
+}
+`,
+			},
+			{
+				Config: providerOnly,
+				Check: func(*terraform.State) error {
+					if !deleted {
+						t.Error("expected the team to be deleted")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestDevResource_Metadata(t *testing.T) {
+	var current client.Dev
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testTeamEngineers)
+		case (r.Method == "POST" && r.URL.Path == "/dev") || (r.Method == "PUT" && r.URL.Path == "/dev/test-id-1"):
+			// Decode into a fresh value so omitted fields are cleared, as
+			// a full replace on the server would.
+			var dev client.Dev
+			json.NewDecoder(r.Body).Decode(&dev)
+			dev.ID = "test-id-1"
+			current = dev
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && r.URL.Path == "/dev/id/test-id-1":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := func(lead string) string {
+		return `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_dev" "test" {
+  name             = "Dev Team Alpha"
+  engineers        = ["e1", "e2"]
+  force_destroy    = true
+  description      = "Builds the platform"
+  lead_engineer_id = "` + lead + `"
+  labels           = { tier = "core" }
+  slack_channel    = "#dev-alpha"
+  email_alias      = "Dev-Alpha@Example.com"
 }
 `
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("e3"),
+				ExpectError: regexp.MustCompile(`lead_engineer_id "e3" must also be listed in engineers`),
+			},
+			{
+				Config: config("e1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_dev.test", "description", "Builds the platform"),
+					resource.TestCheckResourceAttr("devops_dev.test", "lead_engineer_id", "e1"),
+					resource.TestCheckResourceAttr("devops_dev.test", "labels.tier", "core"),
+					resource.TestCheckResourceAttr("devops_dev.test", "slack_channel", "#dev-alpha"),
+					resource.TestCheckResourceAttr("devops_dev.test", "email_alias", "Dev-Alpha@Example.com"),
+				),
+			},
+			{
+				// The alias is sent normalized; the differently cased
+				// configured value is not drift.
+				PreConfig: func() {
+					if current.EmailAlias != "dev-alpha@example.com" {
+						t.Errorf("expected a normalized email alias to be sent, got %q", current.EmailAlias)
+					}
+				},
+				Config:   config("e1"),
+				PlanOnly: true,
+			},
+			{
+				// Changes made outside Terraform are picked up on refresh.
+				PreConfig: func() {
+					current.Description = "Changed by hand"
+					current.Labels = map[string]string{"tier": "edge"}
+				},
+				Config:             config("e1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_dev" "test" {
+  name          = "Dev Team Alpha"
+  engineers     = ["e1", "e2"]
+  force_destroy = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devops_dev.test", "description"),
+					resource.TestCheckNoResourceAttr("devops_dev.test", "lead_engineer_id"),
+					resource.TestCheckNoResourceAttr("devops_dev.test", "labels.%"),
+					resource.TestCheckNoResourceAttr("devops_dev.test", "email_alias"),
+				),
+			},
+		},
+	})
 }
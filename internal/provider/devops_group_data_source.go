@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DevOpsGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &DevOpsGroupDataSource{}
+)
+
+// NewDevOpsGroupDataSource is a helper function to simplify the provider implementation.
+func NewDevOpsGroupDataSource() datasource.DataSource {
+	return &DevOpsGroupDataSource{}
+}
+
+// DevOpsGroupDataSource reads a single DevOps group by ID.
+type DevOpsGroupDataSource struct {
+	client *client.Client
+}
+
+func (d *DevOpsGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+// Metadata returns the data source type name.
+func (d *DevOpsGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devops"
+}
+
+// Schema defines the schema for the data source.
+func (d *DevOpsGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+			},
+			"dev_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"ops_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DevOpsGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state devOpsResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devOps, err := d.client.GetDevOps(ctx, state.ID.ValueString())
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Unable to Read DevOps",
+			"Could not read DevOps ID: "+state.ID.ValueString()+": ",
+			err,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClient(ctx, devOps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DevOpsResource{}
+	_ resource.ResourceWithConfigure   = &DevOpsResource{}
+	_ resource.ResourceWithImportState = &DevOpsResource{}
+	_ resource.ResourceWithIdentity    = &DevOpsResource{}
+)
+
+// NewDevOpsResource is a helper function to simplify the provider implementation.
+func NewDevOpsResource() resource.Resource {
+	return &DevOpsResource{}
+}
+
+// DevOpsResource manages a DevOps group: a product group made of dev and ops
+// teams.
+type DevOpsResource struct {
+	client *client.Client
+}
+
+type devOpsResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	DevIDs types.Set    `tfsdk:"dev_ids"`
+	OpsIDs types.Set    `tfsdk:"ops_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *DevOpsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devops"
+}
+
+// Schema defines the schema for the resource.
+func (r *DevOpsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"ops_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// toClient builds the API request body from the model.
+func (m devOpsResourceModel) toClient(ctx context.Context) (client.DevOps, diag.Diagnostics) {
+	var devIDs, opsIDs []string
+
+	diags := m.DevIDs.ElementsAs(ctx, &devIDs, false)
+	diags.Append(m.OpsIDs.ElementsAs(ctx, &opsIDs, false)...)
+
+	devOps := client.DevOps{
+		Devs: make([]client.Dev, len(devIDs)),
+		Ops:  make([]client.Ops, len(opsIDs)),
+	}
+	for i, id := range devIDs {
+		devOps.Devs[i] = client.Dev{ID: id}
+	}
+	for i, id := range opsIDs {
+		devOps.Ops[i] = client.Ops{ID: id}
+	}
+
+	return devOps, diags
+}
+
+// fromClient copies an API response into the model.
+func (m *devOpsResourceModel) fromClient(ctx context.Context, devOps *client.DevOps) diag.Diagnostics {
+	devIDs := make([]string, 0, len(devOps.Devs))
+	for _, dev := range devOps.Devs {
+		devIDs = append(devIDs, dev.ID)
+	}
+
+	opsIDs := make([]string, 0, len(devOps.Ops))
+	for _, ops := range devOps.Ops {
+		opsIDs = append(opsIDs, ops.ID)
+	}
+
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(devOps.ID)
+	m.DevIDs, diags = types.SetValueFrom(ctx, types.StringType, devIDs)
+
+	opsSet, opsDiags := types.SetValueFrom(ctx, types.StringType, opsIDs)
+	diags.Append(opsDiags...)
+	m.OpsIDs = opsSet
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DevOpsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan devOpsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devOps, diags := plan.toClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateDevOps(ctx, devOps)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error creating DevOps",
+			"Could not create DevOps group, unexpected error: ",
+			err,
+		)
+
+		return
+	}
+
+	// The create response may omit members, so state takes the planned
+	// sets and only the ID from the API.
+	plan.ID = types.StringValue(created.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DevOpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state devOpsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devOps, err := r.client.GetDevOps(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		// The object was deleted outside of Terraform; drop it from state
+		// so the next plan re-creates it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading DevOps Resource",
+			"Could not read DevOps: "+state.ID.ValueString()+": ",
+			err,
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClient(ctx, devOps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DevOpsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan devOpsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devOps, diags := plan.toClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateDevOps(ctx, plan.ID.ValueString(), devOps)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Updating DevOps",
+			"Could not update DevOps ID: "+plan.ID.ValueString()+", error: ",
+			err,
+		)
+
+		return
+	}
+
+	updated, err := r.client.GetDevOps(ctx, plan.ID.ValueString())
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading DevOps",
+			"Could not read DevOps ID: "+plan.ID.ValueString()+": ",
+			err,
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromClient(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DevOpsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state devOpsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOps(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		// Already gone, which is what Delete wanted.
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting DevOps Resource",
+			"Could not delete DevOps with ID: "+state.ID.ValueString()+" error: ",
+			err,
+		)
+		return
+	}
+}
+
+func (r *DevOpsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing DevOps group by API ID. Read fills in the
+// remaining attributes.
+func (r *DevOpsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := importID(ctx, r.client, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *DevOpsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
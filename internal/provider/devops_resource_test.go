@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-devops/internal/provider/client"
+)
+
+func TestDevOpsResource(t *testing.T) {
+	var current client.DevOps
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/devops":
+			json.NewDecoder(r.Body).Decode(&current)
+			current.ID = "devops-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/devops/id/"):
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/devops/"):
+			json.NewDecoder(r.Body).Decode(&current)
+			current.ID = "devops-1"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDevOpsResourceConfigWithHost(server.URL, []string{"d1", "d2"}, []string{"o1"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_devops.test", "id", "devops-1"),
+					resource.TestCheckResourceAttr("devops_devops.test", "dev_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("devops_devops.test", "dev_ids.*", "d1"),
+					resource.TestCheckTypeSetElemAttr("devops_devops.test", "dev_ids.*", "d2"),
+					resource.TestCheckResourceAttr("devops_devops.test", "ops_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("devops_devops.test", "ops_ids.*", "o1"),
+				),
+			},
+			{
+				Config: testDevOpsResourceConfigWithHost(server.URL, []string{"d2"}, []string{"o1", "o2"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_devops.test", "dev_ids.#", "1"),
+					resource.TestCheckResourceAttr("devops_devops.test", "ops_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("devops_devops.test", "ops_ids.*", "o2"),
+				),
+			},
+			{
+				Config: testDevOpsResourceConfigWithHost(server.URL, []string{"d2"}, []string{"o1", "o2"}) + `
+data "devops_devops" "test" {
+  id = devops_devops.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_devops.test", "dev_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.devops_devops.test", "dev_ids.*", "d2"),
+					resource.TestCheckResourceAttr("data.devops_devops.test", "ops_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "devops_devops.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDevOpsResourceConfigWithHost(host string, devIDs, opsIDs []string) string {
+	devJSON, _ := json.Marshal(devIDs)
+	opsJSON, _ := json.Marshal(opsIDs)
+	return `
+provider "devops" {
+  host = "` + host + `"
+}
+
+resource "devops_devops" "test" {
+  dev_ids = ` + string(devJSON) + `
+  ops_ids = ` + string(opsJSON) + `
+}
+`
+}
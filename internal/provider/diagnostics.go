@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// addAPIError appends an error diagnostic for a failed client call. detail is
+// prefixed to the error text. Authentication and authorization failures get
+// their own summary so they are not mistaken for problems with the object
+// being managed.
+func addAPIError(diags *diag.Diagnostics, summary string, detail string, err error) {
+	switch {
+	case client.IsUnauthorized(err):
+		diags.AddError(
+			"DevOps API Authentication Failed",
+			"The API rejected the provider's credentials. Check the token, or username and password, set in the "+
+				"provider block or through DEVOPS_TOKEN, DEVOPS_USERNAME and DEVOPS_PASSWORD.\n\n"+detail+err.Error(),
+		)
+	case client.IsForbidden(err):
+		diags.AddError(
+			"DevOps API Permission Denied",
+			"The configured credentials are not allowed to perform this operation.\n\n"+detail+err.Error(),
+		)
+	default:
+		diags.AddError(summary, detail+err.Error())
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Email addresses are compared case-insensitively, so "Alice@Example.com"
+// in configuration and "alice@example.com" from the API are the same value
+// and never show up as drift.
+var (
+	_ basetypes.StringTypable                    = emailType{}
+	_ basetypes.StringValuableWithSemanticEquals = emailValue{}
+)
+
+// emailType is a string type whose values compare case-insensitively.
+type emailType struct {
+	basetypes.StringType
+}
+
+func (t emailType) Equal(o attr.Type) bool {
+	other, ok := o.(emailType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t emailType) String() string {
+	return "emailType"
+}
+
+func (t emailType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return emailValue{StringValue: in}, nil
+}
+
+func (t emailType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return emailValue{StringValue: stringValue}, nil
+}
+
+func (t emailType) ValueType(_ context.Context) attr.Value {
+	return emailValue{}
+}
+
+// emailValue is an email address held in state.
+type emailValue struct {
+	basetypes.StringValue
+}
+
+// newEmailValue returns a known email value.
+func newEmailValue(email string) emailValue {
+	return emailValue{StringValue: basetypes.NewStringValue(email)}
+}
+
+func (v emailValue) Equal(o attr.Value) bool {
+	other, ok := o.(emailValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v emailValue) Type(_ context.Context) attr.Type {
+	return emailType{}
+}
+
+// StringSemanticEquals lets the framework keep the configured spelling of an
+// address when the API returns it in a different case.
+func (v emailValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(emailValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+// normalizeEmail returns the form of email sent to the API.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// emailHasDomain reports whether email belongs to domain, ignoring case.
+func emailHasDomain(email, domain string) bool {
+	_, emailDomain, ok := strings.Cut(email, "@")
+	return ok && strings.EqualFold(emailDomain, strings.TrimPrefix(domain, "@"))
+}
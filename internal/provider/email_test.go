@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEmailValue_StringSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want bool
+	}{
+		{"alice@example.com", "alice@example.com", true},
+		{"Alice@Example.com", "alice@example.com", true},
+		{"alice@example.com", "bob@example.com", false},
+	} {
+		got, diags := newEmailValue(tc.a).StringSemanticEquals(context.Background(), newEmailValue(tc.b))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != tc.want {
+			t.Errorf("%q vs %q: got %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestEmailHasDomain(t *testing.T) {
+	for _, tc := range []struct {
+		email, domain string
+		want          bool
+	}{
+		{"alice@example.com", "example.com", true},
+		{"alice@Example.COM", "example.com", true},
+		{"alice@example.com", "@example.com", true},
+		{"alice@sub.example.com", "example.com", false},
+		{"alice@notexample.com", "example.com", false},
+		{"alice", "example.com", false},
+	} {
+		if got := emailHasDomain(tc.email, tc.domain); got != tc.want {
+			t.Errorf("emailHasDomain(%q, %q) = %v, want %v", tc.email, tc.domain, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &EngineerDataSource{}
+	_ datasource.DataSourceWithConfigure      = &EngineerDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &EngineerDataSource{}
+)
+
+// NewEngineerDataSource is a helper function to simplify the provider implementation.
+func NewEngineerDataSource() datasource.DataSource {
+	return &EngineerDataSource{}
+}
+
+// EngineerDataSource looks up a single engineer by ID, email or name.
+type EngineerDataSource struct {
+	client *client.Client
+}
+
+func (d *EngineerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+// Metadata returns the data source type name.
+func (d *EngineerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engineer"
+}
+
+// Schema defines the schema for the data source.
+func (d *EngineerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// The lookup attributes are also configurable; everything else is
+	// computed.
+	attributes := engineerAttributes()
+	for _, name := range []string{"id", "email", "name"} {
+		attributes[name] = schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// ValidateConfig requires exactly one lookup attribute. Unknown values are
+// counted as set, since they will be by the time Read runs.
+func (d *EngineerDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config engineerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var set int
+	for _, v := range []types.String{config.ID, config.Email, config.Name} {
+		if !v.IsNull() {
+			set++
+		}
+	}
+
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Engineer Lookup",
+			fmt.Sprintf("Exactly one of id, email or name must be set, got %d.", set),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *EngineerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state engineerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var engineer *client.Engineer
+
+	if !state.ID.IsNull() {
+		var err error
+		engineer, err = d.client.GetEngineer(ctx, state.ID.ValueString())
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"No Matching Engineer",
+				fmt.Sprintf("No engineer has id %q.", state.ID.ValueString()),
+			)
+			return
+		}
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Unable to Read Engineer",
+				"Could not read engineer "+state.ID.ValueString()+": ",
+				err,
+			)
+			return
+		}
+	} else {
+		lookup := listLookup[client.Engineer]{
+			title: "Engineer",
+			key:   "email",
+			matches: func(e client.Engineer, email string) bool {
+				return strings.EqualFold(e.Email, email)
+			},
+			id: func(e client.Engineer) string { return e.ID },
+		}
+		value := state.Email.ValueString()
+
+		if !state.Name.IsNull() {
+			lookup.key = "name"
+			lookup.matches = func(e client.Engineer, name string) bool { return e.Name == name }
+			value = state.Name.ValueString()
+		}
+
+		engineers, err := d.client.GetEngineers(ctx)
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Unable to Read Engineers",
+				"Could not list engineers to find "+lookup.key+" "+value+": ",
+				err,
+			)
+			return
+		}
+
+		match, diags := lookup.match(engineers, value, "Look the engineer up by id instead.")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		engineer = &match
+	}
+
+	// Emails match case-insensitively; keep the configured spelling so the
+	// result conforms to the configuration.
+	email := state.Email
+	state = newEngineerModel(*engineer)
+	if !email.IsNull() {
+		state.Email = email
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
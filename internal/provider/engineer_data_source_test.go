@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-devops/internal/provider/client"
+)
+
+func TestEngineerDataSource(t *testing.T) {
+	engineers := []client.Engineer{
+		{ID: "e1", Name: "Alice", Email: "alice@example.com"},
+		{ID: "e2", Name: "Bob", Email: "bob@example.com"},
+		{ID: "e3", Name: "Bob", Email: "bob.two@example.com"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(engineers)
+		case r.Method == "GET" && r.URL.Path == "/engineers/id/e1":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(engineers[0])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEngineerDataSourceConfigWithHost(server.URL, `id = "e1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_engineer.test", "name", "Alice"),
+					resource.TestCheckResourceAttr("data.devops_engineer.test", "email", "alice@example.com"),
+				),
+			},
+			{
+				Config: testEngineerDataSourceConfigWithHost(server.URL, `email = "Alice@Example.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_engineer.test", "id", "e1"),
+					resource.TestCheckResourceAttr("data.devops_engineer.test", "email", "Alice@Example.com"),
+				),
+			},
+			{
+				Config: testEngineerDataSourceConfigWithHost(server.URL, `name = "Alice"`),
+				Check:  resource.TestCheckResourceAttr("data.devops_engineer.test", "id", "e1"),
+			},
+			{
+				Config:      testEngineerDataSourceConfigWithHost(server.URL, `name = "Bob"`),
+				ExpectError: regexp.MustCompile("Multiple Matching Engineers"),
+			},
+			{
+				Config:      testEngineerDataSourceConfigWithHost(server.URL, `email = "carol@example.com"`),
+				ExpectError: regexp.MustCompile("No Matching Engineer"),
+			},
+			{
+				Config:      testEngineerDataSourceConfigWithHost(server.URL, `id = "missing"`),
+				ExpectError: regexp.MustCompile("No Matching Engineer"),
+			},
+			{
+				Config:      testEngineerDataSourceConfigWithHost(server.URL, "id = \"e1\"\n  name = \"Alice\""),
+				ExpectError: regexp.MustCompile("Exactly one of id, email or name must be set"),
+			},
+			{
+				Config:      testEngineerDataSourceConfigWithHost(server.URL, ""),
+				ExpectError: regexp.MustCompile("Exactly one of id, email or name must be set"),
+			},
+		},
+	})
+}
+
+func testEngineerDataSourceConfigWithHost(host, lookup string) string {
+	return `
+provider "devops" {
+  host = "` + host + `"
+}
+
+data "devops_engineer" "test" {
+  ` + lookup + `
+}
+`
+}
@@ -3,10 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"terraform-provider-devops/internal/provider/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	// "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	// "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,8 +20,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &EngineerResource{}
-	_ resource.ResourceWithConfigure = &EngineerResource{}
+	_ resource.Resource                = &EngineerResource{}
+	_ resource.ResourceWithConfigure   = &EngineerResource{}
+	_ resource.ResourceWithImportState = &EngineerResource{}
+	_ resource.ResourceWithIdentity    = &EngineerResource{}
+	_ resource.ResourceWithModifyPlan  = &EngineerResource{}
 )
 
 // NewEngineerResource is a helper function to simplify the provider implementation.
@@ -31,9 +40,81 @@ type EngineerResource struct {
 type engineerResourceModel struct {
 	ID    types.String `tfsdk:"id"`
 	Name  types.String `tfsdk:"name"`
-	Email types.String `tfsdk:"email"`
+	Email emailValue   `tfsdk:"email"`
+
+	Title          types.String `tfsdk:"title"`
+	Seniority      types.String `tfsdk:"seniority"`
+	GithubUsername types.String `tfsdk:"github_username"`
+	SlackHandle    types.String `tfsdk:"slack_handle"`
+	ManagerID      types.String `tfsdk:"manager_id"`
+	Location       types.String `tfsdk:"location"`
+	Timezone       types.String `tfsdk:"timezone"`
+	StartDate      types.String `tfsdk:"start_date"`
+	Active         types.Bool   `tfsdk:"active"`
+
+	OnDelete types.String `tfsdk:"on_delete"`
 }
 
+// engineerSeniorities are the accepted seniority levels.
+var engineerSeniorities = []string{"junior", "mid", "senior", "staff", "principal"}
+
+// toClient builds the API request body from the model. Null optional fields
+// become empty strings, which the client leaves out of the request.
+func (m engineerResourceModel) toClient() client.Engineer {
+	engineer := client.Engineer{
+		Name:           m.Name.ValueString(),
+		Email:          normalizeEmail(m.Email.ValueString()),
+		Title:          m.Title.ValueString(),
+		Seniority:      m.Seniority.ValueString(),
+		GithubUsername: m.GithubUsername.ValueString(),
+		SlackHandle:    m.SlackHandle.ValueString(),
+		ManagerID:      m.ManagerID.ValueString(),
+		Location:       m.Location.ValueString(),
+		Timezone:       m.Timezone.ValueString(),
+		StartDate:      m.StartDate.ValueString(),
+	}
+
+	if !m.Active.IsNull() && !m.Active.IsUnknown() {
+		active := m.Active.ValueBool()
+		engineer.Active = &active
+	}
+
+	return engineer
+}
+
+// fromClient copies an API response into the model. Empty optional fields
+// are stored as null. An API that does not report active leaves the planned
+// value in place.
+func (m *engineerResourceModel) fromClient(e *client.Engineer) {
+	m.ID = types.StringValue(e.ID)
+	m.Name = types.StringValue(e.Name)
+	m.Email = newEmailValue(e.Email)
+	m.Title = stringOrNull(e.Title)
+	m.Seniority = stringOrNull(e.Seniority)
+	m.GithubUsername = stringOrNull(e.GithubUsername)
+	m.SlackHandle = stringOrNull(e.SlackHandle)
+	m.ManagerID = stringOrNull(e.ManagerID)
+	m.Location = stringOrNull(e.Location)
+	m.Timezone = stringOrNull(e.Timezone)
+	m.StartDate = stringOrNull(e.StartDate)
+
+	switch {
+	case e.Active != nil:
+		m.Active = types.BoolValue(*e.Active)
+	case m.Active.IsNull() || m.Active.IsUnknown():
+		m.Active = types.BoolValue(true)
+	}
+}
+
+// on_delete values: what destroying an engineer does about the dev and ops
+// teams that still list them.
+const (
+	// onDeleteFail refuses to delete the engineer and names the teams.
+	onDeleteFail = "fail"
+	// onDeleteDetach removes the engineer from those teams first.
+	onDeleteDetach = "detach"
+)
+
 // Metadata returns the resource type name.
 func (r *EngineerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_engineer"
@@ -53,12 +134,93 @@ func (r *EngineerResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required: true,
 			},
 			"email": schema.StringAttribute{
-				Required: true,
+				Required:   true,
+				CustomType: emailType{},
+				Validators: []validator.String{
+					emailAddress(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringNotEmpty()},
+			},
+			"seniority": schema.StringAttribute{
+				Optional:    true,
+				Description: "One of " + strings.Join(engineerSeniorities, ", ") + ".",
+				Validators:  []validator.String{stringOneOf(engineerSeniorities...)},
+			},
+			"github_username": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{githubUsername()},
+			},
+			"slack_handle": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringNotEmpty()},
+			},
+			"manager_id": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringNotEmpty()},
+			},
+			"location": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringNotEmpty()},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Description: "IANA time zone name, such as \"Europe/Berlin\".",
+				Validators:  []validator.String{ianaTimezone()},
+			},
+			"start_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC 3339 date, such as \"2024-03-01\".",
+				Validators:  []validator.String{rfc3339Date()},
+			},
+			"active": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"on_delete": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onDeleteFail),
+				Description: "What to do on destroy when the engineer is still a member of dev or ops teams: \"fail\" (the default) stops with an error naming the teams, \"detach\" removes the engineer from them first, clearing lead_engineer_id on teams they lead.",
+				Validators: []validator.String{
+					stringOneOf(onDeleteFail, onDeleteDetach),
+				},
 			},
 		},
 	}
 }
 
+// ModifyPlan rejects emails outside the provider's allowed_email_domains.
+// The check runs at plan time because validators cannot see provider
+// configuration.
+func (r *EngineerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || len(r.client.AllowedEmailDomains) == 0 {
+		return
+	}
+
+	var email emailValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("email"), &email)...)
+	if resp.Diagnostics.HasError() || email.IsNull() || email.IsUnknown() {
+		return
+	}
+
+	for _, domain := range r.client.AllowedEmailDomains {
+		if emailHasDomain(email.ValueString(), domain) {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("email"),
+		"Email Domain Not Allowed",
+		fmt.Sprintf("%q is not in one of the provider's allowed_email_domains: %s.",
+			email.ValueString(), strings.Join(r.client.AllowedEmailDomains, ", ")),
+	)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *EngineerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan engineerResourceModel
@@ -69,25 +231,29 @@ func (r *EngineerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	var engineer = client.Engineer{
-		Name:  plan.Name.ValueString(),
-		Email: plan.Email.ValueString(),
-	}
+	createdEngineer, err := r.client.CreateEngineer(ctx, plan.toClient())
 
-	createdEngineer, err := r.client.CreateEngineer(engineer)
+	if client.IsConflict(err) {
+		resp.Diagnostics.AddError(
+			"Engineer Already Exists",
+			"The API rejected the create request as a conflict with an existing object: "+err.Error(),
+		)
+
+		return
+	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error creating Engineer",
-			"Could not create order, unexpected error: "+err.Error(),
+			"Could not create order, unexpected error: ",
+			err,
 		)
 
 		return
 	}
 
-	plan.ID = types.StringValue(createdEngineer.ID)
-	plan.Name = types.StringValue(createdEngineer.Name)
-	plan.Email = types.StringValue(createdEngineer.Email)
+	plan.fromClient(createdEngineer)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -95,6 +261,7 @@ func (r *EngineerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -108,20 +275,33 @@ func (r *EngineerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	engineer, err := r.client.GetEngineer(state.ID.ValueString())
+	engineer, err := r.client.GetEngineer(ctx, state.ID.ValueString())
+
+	if client.IsNotFound(err) {
+		// The object was deleted outside of Terraform; drop it from state
+		// so the next plan re-creates it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Reading Engineers",
-			"Could not read Engineer: "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read Engineer: "+state.ID.ValueString()+": ",
+			err,
 		)
 
 		return
 	}
 
-	state.ID = types.StringValue(engineer.ID)
-	state.Name = types.StringValue(engineer.Name)
-	state.Email = types.StringValue(engineer.Email)
+	state.fromClient(engineer)
+
+	// on_delete is provider-side only. State written before it existed, and
+	// imported state, take the default so neither shows a diff.
+	if state.OnDelete.IsNull() {
+		state.OnDelete = types.StringValue(onDeleteFail)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -129,6 +309,7 @@ func (r *EngineerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -140,40 +321,39 @@ func (r *EngineerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	engineer := client.Engineer{
-		Name:  plan.Name.ValueString(),
-		Email: plan.Email.ValueString(),
-	}
-
-	_, err := r.client.UpdateEngineer(plan.ID.ValueString(), engineer)
+	_, err := r.client.UpdateEngineer(ctx, plan.ID.ValueString(), plan.toClient())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Updating Engineer",
-			"Could not update engineer ID: "+plan.ID.ValueString()+", error: "+err.Error(),
+			"Could not update engineer ID: "+plan.ID.ValueString()+", error: ",
+			err,
 		)
 
 		return
 	}
 
-	engi, err := r.client.GetEngineer(plan.ID.ValueString())
+	engi, err := r.client.GetEngineer(ctx, plan.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Reading Engineer",
-			"Could not read Engineer ID: "+plan.ID.ValueString()+": "+err.Error(),
+			"Could not read Engineer ID: "+plan.ID.ValueString()+": ",
+			err,
 		)
 
 		return
 	}
 
-	plan.ID = types.StringValue(engi.ID)
-	plan.Name = types.StringValue(engi.Name)
-	plan.Email = types.StringValue(engi.Email)
+	plan.fromClient(engi)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -185,16 +365,114 @@ func (r *EngineerResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeleteEngineer(state.ID.ValueString())
+	memberships, err := r.memberships(ctx, state.ID.ValueString())
 	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting Engineer Resource",
+			"Could not check the team memberships of Engineer "+state.ID.ValueString()+": ",
+			err,
+		)
+		return
+	}
+
+	if len(memberships) > 0 && state.OnDelete.ValueString() != onDeleteDetach {
+		teams := make([]string, len(memberships))
+		for i, m := range memberships {
+			teams[i] = m.String()
+		}
+
 		resp.Diagnostics.AddError(
+			"Engineer Is Still a Team Member",
+			fmt.Sprintf("Engineer %s cannot be deleted while they belong to: %s. "+
+				"Remove them from these teams first, or set on_delete = \"detach\" to have the provider do it.",
+				state.ID.ValueString(), strings.Join(teams, ", ")),
+		)
+		return
+	}
+
+	for _, m := range memberships {
+		if err := m.remove(ctx, state.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+			addAPIError(
+				&resp.Diagnostics,
+				"Error Deleting Engineer Resource",
+				"Could not remove Engineer "+state.ID.ValueString()+" from "+m.String()+": ",
+				err,
+			)
+			return
+		}
+	}
+
+	err = r.client.DeleteEngineer(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		// Already gone, which is what Delete wanted.
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Deleting Engineer Resource",
-			"Could not delete Engineer with ID: "+state.ID.ValueString()+" error: "+err.Error(),
+			"Could not delete Engineer with ID: "+state.ID.ValueString()+" error: ",
+			err,
 		)
 		return
 	}
 }
 
+// engineerMembership is a dev or ops team that lists an engineer.
+type engineerMembership struct {
+	kind   string
+	id     string
+	name   string
+	remove func(ctx context.Context, engineerID string) error
+}
+
+func (m engineerMembership) String() string {
+	return fmt.Sprintf("%s team %q (%s)", m.kind, m.name, m.id)
+}
+
+// memberships returns every dev and ops team that lists engineerID.
+func (r *EngineerResource) memberships(ctx context.Context, engineerID string) ([]engineerMembership, error) {
+	var memberships []engineerMembership
+	isMember := func(e client.Engineer) bool { return e.ID == engineerID }
+
+	devs, err := r.client.GetDevs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, dev := range devs {
+		if slices.ContainsFunc(dev.Engineers, isMember) {
+			memberships = append(memberships, engineerMembership{
+				kind: "dev",
+				id:   dev.ID,
+				name: dev.Name,
+				remove: func(ctx context.Context, engineerID string) error {
+					return r.client.RemoveDevEngineer(ctx, dev.ID, engineerID)
+				},
+			})
+		}
+	}
+
+	opsTeams, err := r.client.GetOps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ops := range opsTeams {
+		if slices.ContainsFunc(ops.Engineers, isMember) {
+			memberships = append(memberships, engineerMembership{
+				kind: "ops",
+				id:   ops.ID,
+				name: ops.Name,
+				remove: func(ctx context.Context, engineerID string) error {
+					return r.client.RemoveOpsEngineer(ctx, ops.ID, engineerID)
+				},
+			})
+		}
+	}
+
+	return memberships, nil
+}
+
 func (r *EngineerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -212,3 +490,37 @@ func (r *EngineerResource) Configure(ctx context.Context, req resource.Configure
 
 	r.client = client
 }
+
+// ImportState imports an existing engineer by API ID or, with an ID of the
+// form "email:<address>", by email. Read fills in the remaining attributes.
+func (r *EngineerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	lookup := listLookup[client.Engineer]{
+		title: "Engineer",
+		key:   "email",
+		list:  r.client.GetEngineers,
+		matches: func(e client.Engineer, email string) bool {
+			return strings.EqualFold(e.Email, email)
+		},
+		id: func(e client.Engineer) string { return e.ID },
+	}
+
+	id, diags := importID(ctx, r.client, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags = lookup.resolve(ctx, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *EngineerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
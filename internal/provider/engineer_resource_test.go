@@ -4,10 +4,15 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"terraform-provider-devops/internal/provider/client"
 )
 
@@ -28,6 +33,12 @@ func TestEngineerResource_Schema(t *testing.T) {
 			}
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(engineer)
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode([]client.Engineer{{ID: "test-id-1", Name: "Alice", Email: "alice@example.com"}})
+		case r.Method == "GET" && (r.URL.Path == "/dev" || r.URL.Path == "/op"):
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
 		case r.Method == "DELETE":
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"message": "resource deleted"}`))
@@ -47,6 +58,31 @@ func TestEngineerResource_Schema(t *testing.T) {
 					resource.TestCheckResourceAttr("devops_engineer.test", "email", "alice@example.com"),
 					resource.TestCheckResourceAttrSet("devops_engineer.test", "id"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValue("devops_engineer.test", tfjsonpath.New("id"), knownvalue.StringExact("test-id-1")),
+					statecheck.ExpectIdentityValue("devops_engineer.test", tfjsonpath.New("host"), knownvalue.StringExact(server.URL)),
+				},
+			},
+			{
+				ResourceName:      "devops_engineer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:    "devops_engineer.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				ResourceName:    "devops_engineer.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+			},
+			{
+				ResourceName:      "devops_engineer.test",
+				ImportState:       true,
+				ImportStateId:     "email:Alice@Example.com",
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -74,6 +110,9 @@ func TestEngineerResource_Update(t *testing.T) {
 			currentEngineer.ID = "test-id-1"
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(currentEngineer)
+		case r.Method == "GET" && (r.URL.Path == "/dev" || r.URL.Path == "/op"):
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
 		case r.Method == "DELETE":
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"message": "resource deleted"}`))
@@ -118,3 +157,312 @@ resource "devops_engineer" "test" {
 }
 `
 }
+
+func TestEngineerResource_DeletedOutsideTerraform(t *testing.T) {
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/engineers":
+			deleted = false
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(client.Engineer{ID: "test-id-1", Name: "Alice", Email: "alice@example.com"})
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/engineers/id/") && !deleted:
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(client.Engineer{ID: "test-id-1", Name: "Alice", Email: "alice@example.com"})
+		case r.Method == "DELETE" && !deleted:
+			deleted = true
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "engineer not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEngineerResourceConfigWithHost(server.URL, "Alice", "alice@example.com"),
+			},
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             testEngineerResourceConfigWithHost(server.URL, "Alice", "alice@example.com"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestEngineerResource_EmailValidation(t *testing.T) {
+	var current client.Engineer
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/engineers":
+			json.NewDecoder(r.Body).Decode(&current)
+			if current.Email != strings.ToLower(current.Email) {
+				t.Errorf("expected a normalized email, got %q", current.Email)
+			}
+			current.ID = "test-id-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/engineers/id/"):
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && (r.URL.Path == "/dev" || r.URL.Path == "/op"):
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEngineerResourceConfigWithHost(server.URL, "Alice", "Alice <alice@example.com>"),
+				ExpectError: regexp.MustCompile("Invalid Email Address"),
+			},
+			{
+				Config:      testEngineerResourceConfigWithHost(server.URL, "Alice", "alice.example.com"),
+				ExpectError: regexp.MustCompile("Invalid Email Address"),
+			},
+			{
+				Config: `
+provider "devops" {
+  host                  = "` + server.URL + `"
+  allowed_email_domains = ["corp.example.com"]
+}
+
+resource "devops_engineer" "test" {
+  name  = "Alice"
+  email = "alice@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile("Email Domain Not Allowed"),
+			},
+			{
+				// The API stores the lowercased address; the configured
+				// spelling is kept and the follow-up plan is empty.
+				Config: testEngineerResourceConfigWithHost(server.URL, "Alice", "Alice@Example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineer.test", "email", "Alice@Example.com"),
+					func(*terraform.State) error {
+						if current.Email != "alice@example.com" {
+							t.Errorf("expected the API to hold alice@example.com, got %q", current.Email)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestEngineerResource_OnDelete(t *testing.T) {
+	engineer := client.Engineer{ID: "test-id-1", Name: "Alice", Email: "alice@example.com"}
+	dev := client.Dev{ID: "d1", Name: "Dev Team Alpha", Engineers: []client.Engineer{{ID: "e0"}, {ID: "test-id-1"}}}
+	ops := client.Ops{ID: "o1", Name: "Ops Team Alpha", Engineers: []client.Engineer{{ID: "test-id-1"}}}
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(engineer)
+		case r.Method == "GET" && r.URL.Path == "/engineers/id/test-id-1" && !deleted:
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(engineer)
+		case r.Method == "GET" && r.URL.Path == "/dev":
+			json.NewEncoder(w).Encode([]client.Dev{dev})
+		case r.Method == "GET" && r.URL.Path == "/dev/id/d1":
+			json.NewEncoder(w).Encode(dev)
+		case r.Method == "PUT" && r.URL.Path == "/dev/d1":
+			json.NewDecoder(r.Body).Decode(&dev)
+			json.NewEncoder(w).Encode(dev)
+		case r.Method == "GET" && r.URL.Path == "/op":
+			json.NewEncoder(w).Encode([]client.Ops{ops})
+		case r.Method == "GET" && r.URL.Path == "/op/id/o1":
+			json.NewEncoder(w).Encode(ops)
+		case r.Method == "PUT" && r.URL.Path == "/op/o1":
+			json.NewDecoder(r.Body).Decode(&ops)
+			json.NewEncoder(w).Encode(ops)
+		case r.Method == "DELETE" && r.URL.Path == "/engineers/test-id-1":
+			deleted = true
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	providerOnly := `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEngineerResourceConfigWithHost(server.URL, "Alice", "alice@example.com"),
+				Check:  resource.TestCheckResourceAttr("devops_engineer.test", "on_delete", "fail"),
+			},
+			{
+				Config:      providerOnly,
+				ExpectError: regexp.MustCompile(`dev team "Dev Team Alpha" \(d1\), ops team "Ops Team Alpha" \(o1\)`),
+			},
+			{
+				Config: providerOnly + `
+resource "devops_engineer" "test" {
+  name      = "Alice"
+  email     = "alice@example.com"
+  on_delete = "detach"
+}
+`,
+				Check: resource.TestCheckResourceAttr("devops_engineer.test", "on_delete", "detach"),
+			},
+			{
+				Config: providerOnly,
+				Check: func(*terraform.State) error {
+					if !deleted {
+						t.Error("expected the engineer to be deleted")
+					}
+					if len(dev.Engineers) != 1 || dev.Engineers[0].ID != "e0" {
+						t.Errorf("expected only e0 to remain in the dev team, got %+v", dev.Engineers)
+					}
+					if len(ops.Engineers) != 0 {
+						t.Errorf("expected the ops team to be empty, got %+v", ops.Engineers)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testEngineerResourceConfigWithHost(server.URL, "Alice", "alice@example.com") + `
+resource "devops_engineer" "bad" {
+  name      = "Bob"
+  email     = "bob@example.com"
+  on_delete = "cascade"
+}
+`,
+				ExpectError: regexp.MustCompile(`on_delete must be one of fail, detach`),
+			},
+		},
+	})
+}
+
+func TestEngineerResource_Profile(t *testing.T) {
+	var current client.Engineer
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/engineers":
+			json.NewDecoder(r.Body).Decode(&current)
+			current.ID = "test-id-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && r.URL.Path == "/engineers/id/test-id-1":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "PUT" && r.URL.Path == "/engineers/test-id-1":
+			current = client.Engineer{}
+			json.NewDecoder(r.Body).Decode(&current)
+			current.ID = "test-id-1"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && (r.URL.Path == "/dev" || r.URL.Path == "/op"):
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := func(profile string) string {
+		return `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_engineer" "test" {
+  name  = "Alice"
+  email = "alice@example.com"
+` + profile + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  title           = "Platform Engineer"
+  seniority       = "senior"
+  github_username = "alice-dev"
+  slack_handle    = "alice"
+  manager_id      = "e0"
+  location        = "Berlin"
+  timezone        = "Europe/Berlin"
+  start_date      = "2024-03-01"
+  active          = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineer.test", "title", "Platform Engineer"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "seniority", "senior"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "github_username", "alice-dev"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "start_date", "2024-03-01"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "devops_engineer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing optional fields clears them; active falls back
+				// to its default.
+				Config: config(`  title = "Staff Engineer"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineer.test", "title", "Staff Engineer"),
+					resource.TestCheckNoResourceAttr("devops_engineer.test", "seniority"),
+					resource.TestCheckNoResourceAttr("devops_engineer.test", "timezone"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "active", "true"),
+				),
+			},
+			{
+				Config:      config(`  timezone = "CEST"`),
+				ExpectError: regexp.MustCompile("Invalid Time Zone"),
+			},
+			{
+				Config:      config(`  start_date = "03/01/2024"`),
+				ExpectError: regexp.MustCompile("Invalid Date"),
+			},
+			{
+				Config:      config(`  github_username = "-alice"`),
+				ExpectError: regexp.MustCompile("Invalid GitHub Username"),
+			},
+			{
+				Config:      config(`  seniority = "wizard"`),
+				ExpectError: regexp.MustCompile("seniority must be one of"),
+			},
+		},
+	})
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &EngineersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &EngineersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &EngineersDataSource{}
+)
+
+// engineerFilterNames are the names accepted by a filter block. Each maps to
+// a query parameter of the same name on the engineers endpoint.
+var engineerFilterNames = []string{"id", "name", "email", "email_domain"}
+
+// NewEngineersDataSource is a helper function to simplify the provider implementation.
+func NewEngineersDataSource() datasource.DataSource {
+	return &EngineersDataSource{}
+}
+
+// EngineersDataSource lists engineers, optionally filtered.
+type EngineersDataSource struct {
+	client *client.Client
+}
+
+func (d *EngineersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+// Metadata returns the data source type name.
+func (d *EngineersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engineers"
+}
+
+// Schema defines the schema for the data source.
+func (d *EngineersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional: true,
+			},
+			"email_domain": schema.StringAttribute{
+				Optional: true,
+			},
+			"ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"engineers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: engineerAttributes(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"values": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the regex compiles and filter names are known.
+func (d *EngineersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	// Attributes are read one at a time since a dynamic filter block may
+	// still be unknown.
+	var nameRegex types.String
+	var filterList types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter"), &filterList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !nameRegex.IsNull() && !nameRegex.IsUnknown() {
+		if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"Could not compile name_regex: "+err.Error(),
+			)
+		}
+	}
+
+	if filterList.IsNull() || filterList.IsUnknown() {
+		return
+	}
+
+	var filters []engineerFilterModel
+	resp.Diagnostics.Append(filterList.ElementsAs(ctx, &filters, false)...)
+
+	for i, filter := range filters {
+		if filter.Name.IsUnknown() || slices.Contains(engineerFilterNames, filter.Name.ValueString()) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filter").AtListIndex(i).AtName("name"),
+			"Invalid Filter Name",
+			fmt.Sprintf("Filter name must be one of %s, got %q.", strings.Join(engineerFilterNames, ", "), filter.Name.ValueString()),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *EngineersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state EngineersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, matches := state.engineerFilter(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	engineers, err := d.client.ListEngineers(ctx, filter)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Unable to Read HashiCups Engineers",
+			"",
+			err,
+		)
+		return
+	}
+
+	// Map response body to model. The server may have ignored some or all
+	// of the filter, so every engineer is checked again here.
+	state.Engineers = []engineerModel{}
+	for _, engineer := range engineers {
+		if !matches(engineer) {
+			continue
+		}
+
+		state.Engineers = append(state.Engineers, newEngineerModel(engineer))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// engineerFilter turns the configured filters into the query sent to the
+// API and a predicate applying the same filters locally. The query is a
+// superset of the predicate: values for the same parameter are merged, which
+// a server reads as "any of", while the predicate requires every filter.
+func (m EngineersDataSourceModel) engineerFilter(ctx context.Context, diags *diag.Diagnostics) (client.EngineerFilter, func(client.Engineer) bool) {
+	var query client.EngineerFilter
+	var checks []func(client.Engineer) bool
+
+	add := func(name string, values []string) {
+		switch name {
+		case "id":
+			query.IDs = append(query.IDs, values...)
+			checks = append(checks, func(e client.Engineer) bool {
+				return slices.Contains(values, e.ID)
+			})
+		case "name":
+			query.Names = append(query.Names, values...)
+			checks = append(checks, func(e client.Engineer) bool {
+				return slices.Contains(values, e.Name)
+			})
+		case "email":
+			query.Emails = append(query.Emails, values...)
+			checks = append(checks, func(e client.Engineer) bool {
+				return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, e.Email) })
+			})
+		case "email_domain":
+			query.EmailDomains = append(query.EmailDomains, values...)
+			checks = append(checks, func(e client.Engineer) bool {
+				return slices.ContainsFunc(values, func(v string) bool { return emailHasDomain(e.Email, v) })
+			})
+		}
+	}
+
+	if !m.IDs.IsNull() {
+		var ids []string
+		diags.Append(m.IDs.ElementsAs(ctx, &ids, false)...)
+		add("id", ids)
+	}
+
+	if !m.EmailDomain.IsNull() {
+		add("email_domain", []string{m.EmailDomain.ValueString()})
+	}
+
+	for _, filter := range m.Filters {
+		var values []string
+		diags.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+		add(filter.Name.ValueString(), values)
+	}
+
+	// Regular expressions cannot be pushed to the API, so name_regex is
+	// only applied locally.
+	if !m.NameRegex.IsNull() {
+		re, err := regexp.Compile(m.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", "Could not compile name_regex: "+err.Error())
+		} else {
+			checks = append(checks, func(e client.Engineer) bool { return re.MatchString(e.Name) })
+		}
+	}
+
+	return query, func(e client.Engineer) bool {
+		for _, check := range checks {
+			if !check(e) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// EngineersDataSourceModel maps the data source schema data.
+type EngineersDataSourceModel struct {
+	NameRegex   types.String          `tfsdk:"name_regex"`
+	EmailDomain types.String          `tfsdk:"email_domain"`
+	IDs         types.Set             `tfsdk:"ids"`
+	Filters     []engineerFilterModel `tfsdk:"filter"`
+	Engineers   []engineerModel       `tfsdk:"engineers"`
+}
+
+// engineerFilterModel maps a filter block.
+type engineerFilterModel struct {
+	Name   types.String `tfsdk:"name"`
+	Values types.List   `tfsdk:"values"`
+}
+
+// engineerModel maps engineers schema data.
+type engineerModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+
+	Title          types.String `tfsdk:"title"`
+	Seniority      types.String `tfsdk:"seniority"`
+	GithubUsername types.String `tfsdk:"github_username"`
+	SlackHandle    types.String `tfsdk:"slack_handle"`
+	ManagerID      types.String `tfsdk:"manager_id"`
+	Location       types.String `tfsdk:"location"`
+	Timezone       types.String `tfsdk:"timezone"`
+	StartDate      types.String `tfsdk:"start_date"`
+	Active         types.Bool   `tfsdk:"active"`
+}
+
+// newEngineerModel maps an API engineer. Empty optional fields, and an
+// unreported active flag, are null.
+func newEngineerModel(e client.Engineer) engineerModel {
+	model := engineerModel{
+		ID:             types.StringValue(e.ID),
+		Name:           types.StringValue(e.Name),
+		Email:          types.StringValue(e.Email),
+		Title:          stringOrNull(e.Title),
+		Seniority:      stringOrNull(e.Seniority),
+		GithubUsername: stringOrNull(e.GithubUsername),
+		SlackHandle:    stringOrNull(e.SlackHandle),
+		ManagerID:      stringOrNull(e.ManagerID),
+		Location:       stringOrNull(e.Location),
+		Timezone:       stringOrNull(e.Timezone),
+		StartDate:      stringOrNull(e.StartDate),
+		Active:         types.BoolNull(),
+	}
+	if e.Active != nil {
+		model.Active = types.BoolValue(*e.Active)
+	}
+
+	return model
+}
+
+// engineerAttributes returns the computed attributes describing an engineer
+// in data sources.
+func engineerAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"active": schema.BoolAttribute{
+			Computed: true,
+		},
+	}
+	for _, name := range []string{
+		"id", "name", "email", "title", "seniority", "github_username",
+		"slack_handle", "manager_id", "location", "timezone", "start_date",
+	} {
+		attributes[name] = schema.StringAttribute{
+			Computed: true,
+		}
+	}
+
+	return attributes
+}
+
+// stringOrNull maps an empty API string to null.
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// EngineersInfoModel maps engineers info data
+type EngineersInfoModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
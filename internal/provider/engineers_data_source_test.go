@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-devops/internal/provider/client"
+)
+
+var testFilterEngineers = []client.Engineer{
+	{ID: "e1", Name: "Alice", Email: "alice@example.com"},
+	{ID: "e2", Name: "Alan", Email: "alan@Example.com"},
+	{ID: "e3", Name: "Bob", Email: "bob@other.org"},
+}
+
+func TestEngineersDataSourceModel_EngineerFilter(t *testing.T) {
+	stringList := func(values ...string) types.List {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+
+	for name, tc := range map[string]struct {
+		model     EngineersDataSourceModel
+		wantQuery client.EngineerFilter
+		want      []string
+	}{
+		"none": {
+			want: []string{"e1", "e2", "e3"},
+		},
+		"name_regex": {
+			model: EngineersDataSourceModel{NameRegex: types.StringValue("^Al")},
+			want:  []string{"e1", "e2"},
+		},
+		"email_domain": {
+			model:     EngineersDataSourceModel{EmailDomain: types.StringValue("example.com")},
+			wantQuery: client.EngineerFilter{EmailDomains: []string{"example.com"}},
+			want:      []string{"e1", "e2"},
+		},
+		"ids": {
+			model: EngineersDataSourceModel{
+				IDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("e1"), types.StringValue("e3")}),
+			},
+			wantQuery: client.EngineerFilter{IDs: []string{"e1", "e3"}},
+			want:      []string{"e1", "e3"},
+		},
+		"filters are combined": {
+			model: EngineersDataSourceModel{
+				EmailDomain: types.StringValue("example.com"),
+				Filters: []engineerFilterModel{
+					{Name: types.StringValue("name"), Values: stringList("Alan", "Bob")},
+				},
+			},
+			wantQuery: client.EngineerFilter{Names: []string{"Alan", "Bob"}, EmailDomains: []string{"example.com"}},
+			want:      []string{"e2"},
+		},
+		"repeated filter names must all match": {
+			model: EngineersDataSourceModel{
+				Filters: []engineerFilterModel{
+					{Name: types.StringValue("id"), Values: stringList("e1", "e2")},
+					{Name: types.StringValue("id"), Values: stringList("e2", "e3")},
+				},
+			},
+			wantQuery: client.EngineerFilter{IDs: []string{"e1", "e2", "e2", "e3"}},
+			want:      []string{"e2"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			query, matches := tc.model.engineerFilter(context.Background(), &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !reflect.DeepEqual(query, tc.wantQuery) {
+				t.Errorf("query = %+v, want %+v", query, tc.wantQuery)
+			}
+
+			var got []string
+			for _, e := range testFilterEngineers {
+				if matches(e) {
+					got = append(got, e.ID)
+				}
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("matched %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEngineersDataSource(t *testing.T) {
+	// The mock ignores query parameters, like an API without filter
+	// support, so any narrowing must happen in the provider.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && r.URL.Path == "/engineers" {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testFilterEngineers)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEngineersDataSourceConfigWithHost(server.URL, ""),
+				Check:  resource.TestCheckResourceAttr("data.devops_engineers.test", "engineers.#", "3"),
+			},
+			{
+				Config: testEngineersDataSourceConfigWithHost(server.URL, `
+  name_regex   = "^Al"
+  email_domain = "example.com"
+
+  filter {
+    name   = "id"
+    values = ["e2", "e3"]
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_engineers.test", "engineers.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_engineers.test", "engineers.0.id", "e2"),
+				),
+			},
+			{
+				Config:      testEngineersDataSourceConfigWithHost(server.URL, `name_regex = "("`),
+				ExpectError: regexp.MustCompile("Invalid Name Regex"),
+			},
+			{
+				Config: testEngineersDataSourceConfigWithHost(server.URL, `
+  filter {
+    name   = "title"
+    values = ["SRE"]
+  }`),
+				ExpectError: regexp.MustCompile("Invalid Filter Name"),
+			},
+		},
+	})
+}
+
+func testEngineersDataSourceConfigWithHost(host, filters string) string {
+	return `
+provider "devops" {
+  host = "` + host + `"
+}
+
+data "devops_engineers" "test" {` + filters + `
+}
+`
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceIdentityModel is the identity shared by every managed resource:
+// the API ID plus the API host, since IDs are only unique per deployment.
+type resourceIdentityModel struct {
+	ID   types.String `tfsdk:"id"`
+	Host types.String `tfsdk:"host"`
+}
+
+// resourceIdentitySchema returns the identity schema used by every managed
+// resource.
+func resourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "API ID of the object.",
+			},
+			"host": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Base URL of the DevOps API holding the object. Defaults to the provider's host on import.",
+			},
+		},
+	}
+}
+
+// setIdentity records id and the API host as the resource identity. The host
+// is taken from the client only when the identity does not have one yet, on
+// create and import; afterwards the stored host is carried forward, since
+// Terraform rejects an identity that changes for an existing object and the
+// provider's host may be repointed at the same API. It is a no-op when
+// Terraform does not support identity.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, c *client.Client, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil {
+		return diags
+	}
+
+	host := types.StringValue(c.HostURL)
+
+	if !identity.Raw.IsNull() {
+		var prior types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root("host"), &prior)...)
+		if diags.HasError() {
+			return diags
+		}
+		if prior.ValueString() != "" {
+			host = prior
+		}
+	}
+
+	diags.Append(identity.Set(ctx, resourceIdentityModel{
+		ID:   types.StringValue(id),
+		Host: host,
+	})...)
+
+	return diags
+}
+
+// importID returns the import ID from req, reading it from the identity when
+// the practitioner imported by identity instead of by ID. An identity for a
+// different host than the provider's is rejected.
+func importID(ctx context.Context, c *client.Client, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, diags
+	}
+
+	var identity resourceIdentityModel
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if host := identity.Host.ValueString(); host != "" && host != c.HostURL {
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Identity Host Mismatch",
+			fmt.Sprintf("The identity refers to an object on %q, but the provider is configured for %q.", host, c.HostURL),
+		)
+		return "", diags
+	}
+
+	return identity.ID.ValueString(), diags
+}
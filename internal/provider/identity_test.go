@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testIdentity(t *testing.T, id, host string) *tfsdk.ResourceIdentity {
+	t.Helper()
+
+	schema := resourceIdentitySchema()
+	typ := schema.Type().TerraformType(context.Background())
+
+	hostVal := tftypes.NewValue(tftypes.String, nil)
+	if host != "" {
+		hostVal = tftypes.NewValue(tftypes.String, host)
+	}
+
+	return &tfsdk.ResourceIdentity{
+		Schema: schema,
+		Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, id),
+			"host": hostVal,
+		}),
+	}
+}
+
+func TestImportID(t *testing.T) {
+	ctx := context.Background()
+	c := &client.Client{HostURL: "http://localhost:8080"}
+
+	t.Run("uses the import ID when given", func(t *testing.T) {
+		id, diags := importID(ctx, c, resource.ImportStateRequest{ID: "e1"})
+		if diags.HasError() || id != "e1" {
+			t.Errorf("expected e1, got %q (%v)", id, diags)
+		}
+	})
+
+	t.Run("reads the ID from the identity", func(t *testing.T) {
+		id, diags := importID(ctx, c, resource.ImportStateRequest{Identity: testIdentity(t, "e2", "")})
+		if diags.HasError() || id != "e2" {
+			t.Errorf("expected e2, got %q (%v)", id, diags)
+		}
+	})
+
+	t.Run("accepts a matching host", func(t *testing.T) {
+		id, diags := importID(ctx, c, resource.ImportStateRequest{Identity: testIdentity(t, "e3", c.HostURL)})
+		if diags.HasError() || id != "e3" {
+			t.Errorf("expected e3, got %q (%v)", id, diags)
+		}
+	})
+
+	t.Run("rejects a different host", func(t *testing.T) {
+		_, diags := importID(ctx, c, resource.ImportStateRequest{Identity: testIdentity(t, "e4", "https://other.example.com")})
+		if !diags.HasError() {
+			t.Error("expected host mismatch error, got none")
+		}
+	})
+}
+
+func TestSetIdentityKeepsStoredHost(t *testing.T) {
+	ctx := context.Background()
+	c := &client.Client{HostURL: "https://devops.example.com"}
+
+	for name, tc := range map[string]struct {
+		identity *tfsdk.ResourceIdentity
+		want     string
+	}{
+		"new identity takes the provider host": {
+			identity: &tfsdk.ResourceIdentity{
+				Schema: resourceIdentitySchema(),
+				Raw:    tftypes.NewValue(resourceIdentitySchema().Type().TerraformType(ctx), nil),
+			},
+			want: c.HostURL,
+		},
+		"identity without a host takes the provider host": {
+			identity: testIdentity(t, "e1", ""),
+			want:     c.HostURL,
+		},
+		"stored host is kept after the provider host changes": {
+			identity: testIdentity(t, "e1", "http://devops.example.com"),
+			want:     "http://devops.example.com",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diags := setIdentity(ctx, tc.identity, c, "e1"); diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+
+			var got resourceIdentityModel
+			if diags := tc.identity.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+			if got.ID.ValueString() != "e1" || got.Host.ValueString() != tc.want {
+				t.Errorf("expected id e1 and host %q, got %s and %s", tc.want, got.ID, got.Host)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// listLookup describes how an attribute value, such as an email address, is
+// resolved to a single object by searching a list endpoint. Imports use it
+// for IDs of the form "<key>:<value>" and data sources for lookups by
+// attribute.
+type listLookup[T any] struct {
+	// title names the object type in diagnostics, e.g. "Engineer".
+	title string
+	// key names the attribute being matched and is the import ID prefix,
+	// e.g. "email".
+	key string
+	// list fetches every candidate object.
+	list func(ctx context.Context) ([]T, error)
+	// matches reports whether item has the requested value.
+	matches func(item T, value string) bool
+	// id returns the API ID of item.
+	id func(item T) string
+}
+
+// resolve returns the API ID for importID. IDs without the lookup prefix, and
+// IDs prefixed with "id:", are returned unchanged.
+func (l listLookup[T]) resolve(ctx context.Context, importID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	noun := strings.ToLower(l.title)
+
+	if id, ok := strings.CutPrefix(importID, "id:"); ok {
+		return id, diags
+	}
+
+	value, ok := strings.CutPrefix(importID, l.key+":")
+	if !ok {
+		return importID, diags
+	}
+
+	if value == "" {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form %q, got %q.", l.key+":<"+l.key+">", importID),
+		)
+		return "", diags
+	}
+
+	items, err := l.list(ctx)
+	if err != nil {
+		addAPIError(
+			&diags,
+			"Error Importing "+l.title,
+			fmt.Sprintf("Could not list %ss to resolve %q: ", noun, importID),
+			err,
+		)
+		return "", diags
+	}
+
+	item, diags := l.match(items, value, "Import by ID instead.")
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return l.id(item), diags
+}
+
+// match returns the one item in items that matches value. Zero or several
+// matches are errors; hint is appended to the latter to suggest a way out.
+func (l listLookup[T]) match(items []T, value string, hint string) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var found []T
+	noun := strings.ToLower(l.title)
+
+	for _, item := range items {
+		if l.matches(item, value) {
+			found = append(found, item)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		diags.AddError(
+			"No Matching "+l.title,
+			fmt.Sprintf("No %s has %s %q.", noun, l.key, value),
+		)
+	case 1:
+		return found[0], diags
+	default:
+		ids := make([]string, len(found))
+		for i, item := range found {
+			ids[i] = l.id(item)
+		}
+		diags.AddError(
+			"Multiple Matching "+l.title+"s",
+			fmt.Sprintf("%d %ss have %s %q (IDs: %s). %s", len(found), noun, l.key, value, strings.Join(ids, ", "), hint),
+		)
+	}
+
+	var zero T
+	return zero, diags
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraform-provider-devops/internal/provider/client"
+)
+
+func testEngineerLookup(engineers []client.Engineer, err error) listLookup[client.Engineer] {
+	return listLookup[client.Engineer]{
+		title: "Engineer",
+		key:   "email",
+		list: func(context.Context) ([]client.Engineer, error) {
+			return engineers, err
+		},
+		matches: func(e client.Engineer, email string) bool { return e.Email == email },
+		id:      func(e client.Engineer) string { return e.ID },
+	}
+}
+
+func TestListLookup_Resolve(t *testing.T) {
+	engineers := []client.Engineer{
+		{ID: "e1", Email: "alice@example.com"},
+		{ID: "e2", Email: "bob@example.com"},
+		{ID: "e3", Email: "bob@example.com"},
+	}
+	lookup := testEngineerLookup(engineers, nil)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		importID string
+		want     string
+		wantErr  string
+	}{
+		{importID: "e1", want: "e1"},
+		{importID: "id:e2", want: "e2"},
+		{importID: "email:alice@example.com", want: "e1"},
+		{importID: "email:carol@example.com", wantErr: "No Matching Engineer"},
+		{importID: "email:bob@example.com", wantErr: "Multiple Matching Engineers"},
+		{importID: "email:", wantErr: "Invalid Import ID"},
+	} {
+		got, diags := lookup.resolve(ctx, tc.importID)
+		if tc.wantErr != "" {
+			if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantErr {
+				t.Errorf("%q: expected error %q, got %v", tc.importID, tc.wantErr, diags)
+			}
+			continue
+		}
+		if diags.HasError() {
+			t.Errorf("%q: expected no error, got %v", tc.importID, diags)
+		}
+		if got != tc.want {
+			t.Errorf("%q: expected %q, got %q", tc.importID, tc.want, got)
+		}
+	}
+}
+
+func TestListLookup_ResolveListError(t *testing.T) {
+	lookup := testEngineerLookup(nil, errors.New("connection refused"))
+
+	_, diags := lookup.resolve(context.Background(), "email:alice@example.com")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Error Importing Engineer" {
+		t.Errorf("expected list error diagnostic, got %v", diags)
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"terraform-provider-devops/internal/provider/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	// "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	// "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,8 +16,13 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &OpsResource{}
-	_ resource.ResourceWithConfigure = &OpsResource{}
+	_ resource.Resource                   = &OpsResource{}
+	_ resource.ResourceWithConfigure      = &OpsResource{}
+	_ resource.ResourceWithImportState    = &OpsResource{}
+	_ resource.ResourceWithIdentity       = &OpsResource{}
+	_ resource.ResourceWithUpgradeState   = &OpsResource{}
+	_ resource.ResourceWithModifyPlan     = &OpsResource{}
+	_ resource.ResourceWithValidateConfig = &OpsResource{}
 )
 
 // NewOpsResource is a helper function to simplify the provider implementation.
@@ -31,7 +38,11 @@ type OpsResource struct {
 type opsResourceModel struct {
 	ID        types.String `tfsdk:"id"`
 	Name      types.String `tfsdk:"name"`
-	Engineers types.List   `tfsdk:"engineers"`
+	Engineers types.Set    `tfsdk:"engineers"`
+
+	ForceDestroy types.Bool `tfsdk:"force_destroy"`
+
+	teamMetadataModel
 }
 
 // Metadata returns the resource type name.
@@ -42,7 +53,8 @@ func (r *OpsResource) Metadata(_ context.Context, req resource.MetadataRequest,
 // Schema defines the schema for the resource.
 func (r *OpsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
+		Version: 1,
+		Attributes: withTeamMetadataAttributes(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
 				// PlanModifiers: []planmodifier.String{
@@ -52,14 +64,30 @@ func (r *OpsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			"name": schema.StringAttribute{
 				Required: true,
 			},
-			"engineers": schema.ListAttribute{
+			"engineers": schema.SetAttribute{
 				Required:    true,
 				ElementType: types.StringType,
 			},
-		},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Delete the team even if the server still lists members, including members added outside Terraform. Defaults to false.",
+			},
+		}),
 	}
 }
 
+// ValidateConfig checks that the lead engineer is a member of the team.
+func (r *OpsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateTeamLead(ctx, req)...)
+}
+
+// ModifyPlan checks that the planned engineers exist.
+func (r *OpsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateTeamEngineers(ctx, r.client, req)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *OpsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan devResourceModel
@@ -81,17 +109,35 @@ func (r *OpsResource) Create(ctx context.Context, req resource.CreateRequest, re
 		engineers[i] = client.Engineer{ID: engiID}
 	}
 
+	metadata, diags := plan.teamMetadataModel.toClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var dev = client.Ops{
-		Name:      plan.Name.ValueString(),
-		Engineers: engineers,
+		Name:         plan.Name.ValueString(),
+		Engineers:    engineers,
+		TeamMetadata: metadata,
 	}
 
-	createdOps, err := r.client.CreateOps(dev)
+	createdOps, err := r.client.CreateOps(ctx, dev)
 
-	if err != nil {
+	if client.IsConflict(err) {
 		resp.Diagnostics.AddError(
+			"Ops Already Exists",
+			"The API rejected the create request as a conflict with an existing object: "+err.Error(),
+		)
+
+		return
+	}
+
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
 			"Error creating Ops",
-			"Could not create order, unexpected error: "+err.Error(),
+			"Could not create order, unexpected error: ",
+			err,
 		)
 
 		return
@@ -99,6 +145,7 @@ func (r *OpsResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	plan.ID = types.StringValue(createdOps.ID)
 	plan.Name = types.StringValue(createdOps.Name)
+	plan.teamMetadataModel.fromClient(createdOps.TeamMetadata)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -106,6 +153,7 @@ func (r *OpsResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -119,22 +167,26 @@ func (r *OpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	dev, err := r.client.GetOp(state.ID.ValueString())
+	dev, err := r.client.GetOp(ctx, state.ID.ValueString())
+
+	if client.IsNotFound(err) {
+		// The object was deleted outside of Terraform; drop it from state
+		// so the next plan re-creates it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Reading Ops Resource",
-			"Could not read Ops: "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read Ops: "+state.ID.ValueString()+": ",
+			err,
 		)
 
 		return
 	}
 
-	if dev == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	state.ID = types.StringValue(dev.ID)
 	state.Name = types.StringValue(dev.Name)
 
@@ -144,7 +196,7 @@ func (r *OpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		engineerIDs = append(engineerIDs, eng.ID)
 	}
 
-	engList, diags2 := types.ListValueFrom(ctx, types.StringType, engineerIDs)
+	engSet, diags2 := types.SetValueFrom(ctx, types.StringType, engineerIDs)
 
 	resp.Diagnostics.Append(diags2...)
 	if resp.Diagnostics.HasError() {
@@ -152,7 +204,15 @@ func (r *OpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	state.Engineers = engList
+	state.Engineers = engSet
+
+	state.teamMetadataModel.fromClient(dev.TeamMetadata)
+
+	// force_destroy is provider-side only. State written before it existed,
+	// and imported state, take the default so neither shows a diff.
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -160,6 +220,7 @@ func (r *OpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -183,26 +244,37 @@ func (r *OpsResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		engs[i] = client.Engineer{ID: engiID}
 	}
 
+	metadata, diags := plan.teamMetadataModel.toClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dev := client.Ops{
-		Name:      plan.Name.ValueString(),
-		Engineers: engs,
+		Name:         plan.Name.ValueString(),
+		Engineers:    engs,
+		TeamMetadata: metadata,
 	}
 
-	_, err := r.client.UpdateOps(plan.ID.ValueString(), dev)
+	_, err := r.client.UpdateOps(ctx, plan.ID.ValueString(), dev)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Updating Ops",
-			"Could not update dev ID: "+plan.ID.ValueString()+", error: "+err.Error(),
+			"Could not update dev ID: "+plan.ID.ValueString()+", error: ",
+			err,
 		)
 
 		return
 	}
 
-	devResp, err := r.client.GetOp(plan.ID.ValueString())
+	devResp, err := r.client.GetOp(ctx, plan.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Reading Ops",
-			"Could not read Ops ID: "+plan.ID.ValueString()+": "+err.Error(),
+			"Could not read Ops ID: "+plan.ID.ValueString()+": ",
+			err,
 		)
 
 		return
@@ -216,19 +288,23 @@ func (r *OpsResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		respEngineerIDs = append(respEngineerIDs, eng.ID)
 	}
 
-	engList, diags2 := types.ListValueFrom(ctx, types.StringType, respEngineerIDs)
+	engSet, diags2 := types.SetValueFrom(ctx, types.StringType, respEngineerIDs)
 	resp.Diagnostics.Append(diags2...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	plan.Engineers = engList
+	plan.Engineers = engSet
+
+	plan.teamMetadataModel.fromClient(devResp.TeamMetadata)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -240,11 +316,39 @@ func (r *OpsResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	err := r.client.DeleteOps(state.ID.ValueString())
+	if !state.ForceDestroy.ValueBool() {
+		team, err := r.client.GetOp(ctx, state.ID.ValueString())
+		if client.IsNotFound(err) {
+			// Already gone, which is what Delete wanted.
+			return
+		}
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Error Deleting Ops Resource",
+				"Could not check the members of Ops "+state.ID.ValueString()+" before deleting: ",
+				err,
+			)
+			return
+		}
+
+		if len(team.Engineers) > 0 {
+			addTeamNotEmptyError(&resp.Diagnostics, "Ops Team", state.ID.ValueString(), team.Engineers)
+			return
+		}
+	}
+
+	err := r.client.DeleteOps(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		// Already gone, which is what Delete wanted.
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Deleting Ops Resource",
-			"Could not delete Ops with ID: "+state.ID.ValueString()+" error: "+err.Error(),
+			"Could not delete Ops with ID: "+state.ID.ValueString()+" error: ",
+			err,
 		)
 		return
 	}
@@ -267,3 +371,42 @@ func (r *OpsResource) Configure(ctx context.Context, req resource.ConfigureReque
 
 	r.client = client
 }
+
+// ImportState imports an existing team by API ID or, with an ID of the form
+// "name:<name>", by name. Read fills in the remaining attributes.
+func (r *OpsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	lookup := listLookup[client.Ops]{
+		title: "Ops Team",
+		key:   "name",
+		list:  r.client.GetOps,
+		matches: func(o client.Ops, name string) bool {
+			return o.Name == name
+		},
+		id: func(o client.Ops) string { return o.ID },
+	}
+
+	id, diags := importID(ctx, r.client, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags = lookup.resolve(ctx, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
+}
+
+// UpgradeState migrates state written by earlier schema versions.
+func (r *OpsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return teamStateUpgraders()
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *OpsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -12,6 +13,8 @@ import (
 )
 
 func TestOpsResource_Schema(t *testing.T) {
+	engineers := []client.Engineer{{ID: "e1"}, {ID: "e2"}}
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case r.Method == "POST" && r.URL.Path == "/op":
@@ -24,10 +27,13 @@ func TestOpsResource_Schema(t *testing.T) {
 			op := client.Ops{
 				ID:        "test-id-1",
 				Name:      "Ops Team Alpha",
-				Engineers: []client.Engineer{{ID: "e1"}, {ID: "e2"}},
+				Engineers: engineers,
 			}
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(op)
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testTeamEngineers)
 		case r.Method == "DELETE":
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"message": "resource deleted"}`))
@@ -46,11 +52,40 @@ func TestOpsResource_Schema(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("devops_ops.test", "name", "Ops Team Alpha"),
 					resource.TestCheckResourceAttr("devops_ops.test", "engineers.#", "2"),
-					resource.TestCheckResourceAttr("devops_ops.test", "engineers.0", "e1"),
-					resource.TestCheckResourceAttr("devops_ops.test", "engineers.1", "e2"),
+					resource.TestCheckTypeSetElemAttr("devops_ops.test", "engineers.*", "e1"),
+					resource.TestCheckTypeSetElemAttr("devops_ops.test", "engineers.*", "e2"),
 					resource.TestCheckResourceAttrSet("devops_ops.test", "id"),
 				),
 			},
+			{
+				ResourceName:      "devops_ops.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// force_destroy is provider-side only, so import takes the
+				// default rather than the configured true.
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				// Import against a config that leaves force_destroy at its
+				// default, so the import plan is a no-op. The team is
+				// emptied first so destroy succeeds without force_destroy.
+				PreConfig: func() {
+					engineers = nil
+				},
+				Config: `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_ops" "test" {
+  name      = "Ops Team Alpha"
+  engineers = []
+}
+`,
+				ResourceName:    "devops_ops.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 		},
 	})
 }
@@ -77,6 +112,9 @@ func TestOpsResource_Update(t *testing.T) {
 			currentOp.ID = "test-id-1"
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(currentOp)
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testTeamEngineers)
 		case r.Method == "DELETE":
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"message": "resource deleted"}`))
@@ -117,8 +155,118 @@ provider "devops" {
 }
 
 resource "devops_ops" "test" {
-  name      = "` + name + `"
-  engineers = ` + string(engineersJSON) + `
+  name          = "` + name + `"
+  engineers     = ` + string(engineersJSON) + `
+  force_destroy = true
+}
+`
+}
+
+func TestOpsResource_Metadata(t *testing.T) {
+	var current client.Ops
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/engineers":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testTeamEngineers)
+		case (r.Method == "POST" && r.URL.Path == "/op") || (r.Method == "PUT" && r.URL.Path == "/op/test-id-1"):
+			// Decode into a fresh value so omitted fields are cleared, as
+			// a full replace on the server would.
+			var op client.Ops
+			json.NewDecoder(r.Body).Decode(&op)
+			op.ID = "test-id-1"
+			current = op
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "GET" && r.URL.Path == "/op/id/test-id-1":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(current)
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "resource deleted"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := func(lead string) string {
+		return `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_ops" "test" {
+  name             = "Ops Team Alpha"
+  engineers        = ["e1", "e2"]
+  force_destroy    = true
+  description      = "Runs the platform"
+  lead_engineer_id = "` + lead + `"
+  labels           = { tier = "core" }
+  slack_channel    = "#ops-alpha"
+  email_alias      = "Ops-Alpha@Example.com"
 }
 `
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("e3"),
+				ExpectError: regexp.MustCompile(`lead_engineer_id "e3" must also be listed in engineers`),
+			},
+			{
+				Config: config("e1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_ops.test", "description", "Runs the platform"),
+					resource.TestCheckResourceAttr("devops_ops.test", "lead_engineer_id", "e1"),
+					resource.TestCheckResourceAttr("devops_ops.test", "labels.tier", "core"),
+					resource.TestCheckResourceAttr("devops_ops.test", "slack_channel", "#ops-alpha"),
+					resource.TestCheckResourceAttr("devops_ops.test", "email_alias", "Ops-Alpha@Example.com"),
+				),
+			},
+			{
+				// The alias is sent normalized; the differently cased
+				// configured value is not drift.
+				PreConfig: func() {
+					if current.EmailAlias != "ops-alpha@example.com" {
+						t.Errorf("expected a normalized email alias to be sent, got %q", current.EmailAlias)
+					}
+				},
+				Config:   config("e1"),
+				PlanOnly: true,
+			},
+			{
+				// Changes made outside Terraform are picked up on refresh.
+				PreConfig: func() {
+					current.Description = "Changed by hand"
+					current.Labels = map[string]string{"tier": "edge"}
+				},
+				Config:             config("e1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+resource "devops_ops" "test" {
+  name          = "Ops Team Alpha"
+  engineers     = ["e1", "e2"]
+  force_destroy = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devops_ops.test", "description"),
+					resource.TestCheckNoResourceAttr("devops_ops.test", "lead_engineer_id"),
+					resource.TestCheckNoResourceAttr("devops_ops.test", "labels.%"),
+					resource.TestCheckNoResourceAttr("devops_ops.test", "email_alias"),
+				),
+			},
+		},
+	})
 }
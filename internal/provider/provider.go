@@ -5,9 +5,19 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"terraform-provider-devops/internal/provider/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,7 +38,27 @@ type DevOpsProvider struct {
 }
 
 type DevOpsProviderModel struct {
-	HostURL types.String `tfsdk:"host"`
+	HostURL      types.String `tfsdk:"host"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+	Token        types.String `tfsdk:"token"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+
+	LogSensitiveFields types.List `tfsdk:"log_sensitive_fields"`
+
+	PageSize types.Int64 `tfsdk:"page_size"`
+
+	AllowedEmailDomains types.List `tfsdk:"allowed_email_domains"`
 }
 
 func (p *DevOpsProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -39,17 +69,148 @@ func (p *DevOpsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Configuration values are now available.
-	// if config.Endpoint.IsNull() { /* ... */ }
+	// Values that come from other resources are unknown until apply, but the
+	// client has to be built now.
+	for _, a := range []struct {
+		name  string
+		value attr.Value
+		env   string
+	}{
+		{"host", config.HostURL, "DEVOPS_HOST"},
+		{"token", config.Token, "DEVOPS_TOKEN"},
+		{"username", config.Username, "DEVOPS_USERNAME"},
+		{"password", config.Password, "DEVOPS_PASSWORD"},
+		{"max_retries", config.MaxRetries, ""},
+		{"retry_max_wait", config.RetryMaxWait, ""},
+		{"ca_cert_file", config.CACertFile, "DEVOPS_CA_CERT_FILE"},
+		{"ca_cert_pem", config.CACertPEM, "DEVOPS_CA_CERT_PEM"},
+		{"client_cert", config.ClientCert, "DEVOPS_CLIENT_CERT"},
+		{"client_key", config.ClientKey, "DEVOPS_CLIENT_KEY"},
+		{"insecure_skip_verify", config.InsecureSkipVerify, "DEVOPS_INSECURE_SKIP_VERIFY"},
+		{"request_timeout", config.RequestTimeout, ""},
+		{"proxy_url", config.ProxyURL, ""},
+		{"log_sensitive_fields", config.LogSensitiveFields, ""},
+		{"page_size", config.PageSize, ""},
+		{"allowed_email_domains", config.AllowedEmailDomains, ""},
+	} {
+		if !a.value.IsUnknown() {
+			continue
+		}
+
+		detail := fmt.Sprintf("The provider cannot create the DevOps API client as there is an unknown configuration value for %q. "+
+			"Either apply the source of the value first or set the value statically in the configuration", a.name)
+		if a.env != "" {
+			detail += ", or use the " + a.env + " environment variable"
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(a.name),
+			"Unknown DevOps API Configuration Value",
+			detail+".",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Each setting resolves from the provider block first, then the
+	// environment, then the client default.
+	host := stringValueOrEnv(config.HostURL, "DEVOPS_HOST")
+	if host == "" {
+		host = client.HostURL
+	}
+
+	host, err := validateHostURL(host)
+	if err != nil {
+		source := "host"
+		if config.HostURL.IsNull() && os.Getenv("DEVOPS_HOST") != "" {
+			source = "DEVOPS_HOST"
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Invalid DevOps API Host",
+			fmt.Sprintf("The %s value %q is not a valid API URL: %s.", source, host, err),
+		)
+	}
+
+	token, username, password, source := resolveCredentials(config)
+
+	if token != "" && username != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Conflicting DevOps API Credentials",
+			"Both a token and a username were set in "+source+". Set either token (DEVOPS_TOKEN) or "+
+				"username and password (DEVOPS_USERNAME, DEVOPS_PASSWORD), not both.",
+		)
+	}
+
+	if username != "" && password == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing DevOps API Password",
+			"A username was configured without a password. Set password in the provider block or the DEVOPS_PASSWORD environment variable.",
+		)
+	}
+
+	tlsConfig := resolveTLSConfig(config, &resp.Diagnostics)
+
+	opts := []client.Option{client.WithTLS(tlsConfig)}
+
+	if !config.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid request_timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"30s\", got %q.", config.RequestTimeout.ValueString()),
+			)
+		}
+		opts = append(opts, client.WithTimeout(timeout))
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL, err := validateProxyURL(config.ProxyURL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid proxy_url",
+				fmt.Sprintf("The proxy_url value %q is not a valid proxy URL: %s.", config.ProxyURL.ValueString(), err),
+			)
+		}
+		opts = append(opts, client.WithProxy(proxyURL))
+	}
+
+	if !config.PageSize.IsNull() {
+		if config.PageSize.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("page_size"),
+				"Invalid page_size",
+				fmt.Sprintf("page_size must be positive, got %d.", config.PageSize.ValueInt64()),
+			)
+		}
+		opts = append(opts, client.WithPageSize(int(config.PageSize.ValueInt64())))
+	}
+
+	if !config.LogSensitiveFields.IsNull() {
+		var fields []string
+		resp.Diagnostics.Append(config.LogSensitiveFields.ElementsAs(ctx, &fields, false)...)
+		opts = append(opts, client.WithSensitiveLogFields(fields...))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Initialize custom API client for data sources and resources
-	var endpointPtr *string
-	if !config.HostURL.IsNull() && !config.HostURL.IsUnknown() {
-		v := config.HostURL.ValueString()
-		endpointPtr = &v
+	switch {
+	case token != "":
+		opts = append(opts, client.WithToken(token))
+	case username != "":
+		opts = append(opts, client.WithBasicAuth(username, password))
 	}
 
-	c, err := client.NewClient(endpointPtr)
+	c, err := client.NewClient(&host, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create API client",
@@ -58,11 +219,236 @@ func (p *DevOpsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		if config.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid max_retries",
+				fmt.Sprintf("max_retries must not be negative, got %d.", config.MaxRetries.ValueInt64()),
+			)
+		}
+		c.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		wait, err := time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil || wait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid retry_max_wait",
+				fmt.Sprintf("retry_max_wait must be a positive duration such as \"30s\", got %q.", config.RetryMaxWait.ValueString()),
+			)
+		}
+		c.RetryMaxWait = wait
+	}
+
+	if !config.AllowedEmailDomains.IsNull() {
+		var domains []string
+		resp.Diagnostics.Append(config.AllowedEmailDomains.ElementsAs(ctx, &domains, false)...)
+		for _, domain := range domains {
+			if trimmed := strings.TrimPrefix(domain, "@"); trimmed == "" || strings.Contains(trimmed, "@") {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("allowed_email_domains"),
+					"Invalid allowed_email_domains",
+					fmt.Sprintf("Each entry must be a domain such as \"example.com\", got %q.", domain),
+				)
+			}
+		}
+		c.AllowedEmailDomains = domains
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.DataSourceData = c
 	resp.ResourceData = c
 
 }
 
+// resolveCredentials picks the auth credentials and names where they came
+// from. A token or username set in the provider block takes precedence over
+// the environment as a whole, so DEVOPS_TOKEN and DEVOPS_USERNAME are ignored
+// and cannot conflict with it. The password still falls back to
+// DEVOPS_PASSWORD.
+func resolveCredentials(config DevOpsProviderModel) (token, username, password, source string) {
+	token = config.Token.ValueString()
+	username = config.Username.ValueString()
+	source = "the provider block"
+
+	if token == "" && username == "" {
+		token = os.Getenv("DEVOPS_TOKEN")
+		username = os.Getenv("DEVOPS_USERNAME")
+		source = "the environment"
+	}
+
+	if username != "" {
+		password = stringValueOrEnv(config.Password, "DEVOPS_PASSWORD")
+	}
+
+	return token, username, password, source
+}
+
+// stringValueOrEnv returns the configured value of v, or the value of the
+// environment variable env when v is null or unknown.
+func stringValueOrEnv(v types.String, env string) string {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// boolValueOrEnv returns the configured value of v, or the value of the
+// environment variable env parsed with strconv.ParseBool when v is null or
+// unknown. An unset environment variable yields false.
+func boolValueOrEnv(v types.Bool, env string) (bool, error) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueBool(), nil
+	}
+
+	raw := os.Getenv(env)
+	if raw == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", env, err)
+	}
+
+	return b, nil
+}
+
+// pemOrFile returns v itself when it holds PEM data and the contents of the
+// file it names otherwise.
+func pemOrFile(v string) ([]byte, error) {
+	if strings.Contains(v, "-----BEGIN") {
+		return []byte(v), nil
+	}
+
+	return os.ReadFile(v)
+}
+
+// resolveTLSConfig reads the TLS attributes, falling back to their
+// environment variables, and loads any referenced files.
+func resolveTLSConfig(config DevOpsProviderModel, diags *diag.Diagnostics) client.TLSConfig {
+	var tlsConfig client.TLSConfig
+
+	caFile := stringValueOrEnv(config.CACertFile, "DEVOPS_CA_CERT_FILE")
+	caPEM := stringValueOrEnv(config.CACertPEM, "DEVOPS_CA_CERT_PEM")
+
+	switch {
+	case caFile != "" && caPEM != "":
+		diags.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Conflicting CA Certificate Settings",
+			"Only one of ca_cert_file (DEVOPS_CA_CERT_FILE) and ca_cert_pem (DEVOPS_CA_CERT_PEM) may be set.",
+		)
+	case caFile != "":
+		b, err := os.ReadFile(caFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Certificate",
+				err.Error(),
+			)
+		}
+		tlsConfig.CACertPEM = b
+	case caPEM != "":
+		tlsConfig.CACertPEM = []byte(caPEM)
+	}
+
+	for _, a := range []struct {
+		name string
+		v    types.String
+		env  string
+		dst  *[]byte
+	}{
+		{"client_cert", config.ClientCert, "DEVOPS_CLIENT_CERT", &tlsConfig.ClientCertPEM},
+		{"client_key", config.ClientKey, "DEVOPS_CLIENT_KEY", &tlsConfig.ClientKeyPEM},
+	} {
+		raw := stringValueOrEnv(a.v, a.env)
+		if raw == "" {
+			continue
+		}
+
+		b, err := pemOrFile(raw)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(a.name),
+				"Unable to Read "+a.name,
+				a.name+" must be PEM data or the path to a PEM file: "+err.Error(),
+			)
+		}
+		*a.dst = b
+	}
+
+	if (len(tlsConfig.ClientCertPEM) > 0) != (len(tlsConfig.ClientKeyPEM) > 0) {
+		diags.AddAttributeError(
+			path.Root("client_key"),
+			"Incomplete Client Certificate",
+			"client_cert and client_key must be set together to enable mutual TLS.",
+		)
+	}
+
+	insecure, err := boolValueOrEnv(config.InsecureSkipVerify, "DEVOPS_INSECURE_SKIP_VERIFY")
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Invalid insecure_skip_verify",
+			err.Error(),
+		)
+	}
+	tlsConfig.InsecureSkipVerify = insecure
+
+	return tlsConfig
+}
+
+// validateHostURL checks that host is an absolute http or https URL and
+// returns it without a trailing slash so paths can be appended to it.
+func validateHostURL(host string) (string, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return host, err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return host, fmt.Errorf("scheme must be http or https, got %q", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return host, errors.New("missing host name")
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" {
+		return host, errors.New("query strings and fragments are not allowed")
+	}
+
+	return strings.TrimRight(host, "/"), nil
+}
+
+// validateProxyURL parses proxy, which must be an http, https or socks5 URL
+// with a host.
+func validateProxyURL(proxy string) (*url.URL, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("scheme must be http, https or socks5, got %q", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, errors.New("missing host name")
+	}
+
+	return u, nil
+}
+
 func (p *DevOpsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "devops"
 	resp.Version = p.version
@@ -73,7 +459,73 @@ func (p *DevOpsProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Base URL of the DevOps API. May also be set with the DEVOPS_HOST environment variable. Defaults to \"http://localhost:8080\".",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of times a failed request is retried. Defaults to 3; 0 disables retries.",
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:    true,
+				Description: "Upper bound on the wait between retries, as a Go duration string. Defaults to \"30s\".",
+			},
+			"token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Bearer token for the DevOps API. May also be set with the DEVOPS_TOKEN environment variable. A token or username in the provider block overrides the DEVOPS_TOKEN and DEVOPS_USERNAME environment variables.",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username for basic auth. May also be set with the DEVOPS_USERNAME environment variable.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for basic auth. May also be set with the DEVOPS_PASSWORD environment variable.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM bundle of CA certificates used to verify the API. May also be set with the DEVOPS_CA_CERT_FILE environment variable.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM bundle of CA certificates used to verify the API. May also be set with the DEVOPS_CA_CERT_PEM environment variable.",
+			},
+			"client_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "Client certificate for mutual TLS, as PEM data or a file path. May also be set with the DEVOPS_CLIENT_CERT environment variable.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Private key for client_cert, as PEM data or a file path. May also be set with the DEVOPS_CLIENT_KEY environment variable.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disable verification of the API's TLS certificate. May also be set with the DEVOPS_INSECURE_SKIP_VERIFY environment variable.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time limit for a single API request, as a Go duration string. Defaults to \"10s\".",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an HTTP or SOCKS5 proxy for API requests. When unset, HTTP_PROXY, HTTPS_PROXY and NO_PROXY are honored.",
+			},
+			"log_sensitive_fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Extra JSON keys whose values are masked in request and response logs. Logs are written to the devops_client subsystem; enable them with TF_LOG_PROVIDER_DEVOPS_CLIENT=trace.",
+			},
+			"page_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of items requested per page from list endpoints. Defaults to the server's page size.",
+			},
+			"allowed_email_domains": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Domains that devops_engineer emails must belong to, such as \"example.com\". Checked at plan time. When unset, any domain is accepted.",
 			},
 		},
 	}
@@ -84,12 +536,21 @@ func (p *DevOpsProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewEngineerResource,
 		NewDevResource,
 		NewOpsResource,
+		NewDevOpsResource,
+		NewDevMembershipResource,
+		NewOpsMembershipResource,
 	}
 }
 
 func (p *DevOpsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		NewDevOpsDataSource,
+		NewEngineersDataSource,
+		NewEngineerDataSource,
+		NewDevDataSource,
+		NewOpsDataSource,
+		NewDevsDataSource,
+		NewOpsTeamsDataSource,
+		NewDevOpsGroupDataSource,
 	}
 }
 
@@ -4,11 +4,19 @@
 package provider
 
 import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 const (
@@ -40,3 +48,357 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestProvider_InvalidRetryConfig(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "devops" {
+  host           = "http://localhost:8080"
+  retry_max_wait = "soon"
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid retry_max_wait`),
+			},
+			{
+				Config: `
+provider "devops" {
+  host        = "http://localhost:8080"
+  max_retries = -1
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid max_retries`),
+			},
+		},
+	})
+}
+
+func TestProvider_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer good-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message": "invalid token"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	t.Setenv("DEVOPS_TOKEN", "bad-token")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`DevOps API Authentication Failed`),
+			},
+			{
+				Config: `
+provider "devops" {
+  host  = "` + server.URL + `"
+  token = "good-token"
+}
+
+data "devops_engineers" "test" {}
+`,
+			},
+		},
+	})
+}
+
+func TestProvider_ConfigCredentialsOverrideEnvironment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer good-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message": "invalid token"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	t.Setenv("DEVOPS_TOKEN", "env-token")
+	t.Setenv("DEVOPS_USERNAME", "alice")
+	t.Setenv("DEVOPS_PASSWORD", "secret")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "devops" {
+  host  = "` + server.URL + `"
+  token = "good-token"
+}
+
+data "devops_engineers" "test" {}
+`,
+			},
+			{
+				Config: `
+provider "devops" {
+  host = "` + server.URL + `"
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Both a token and a username were set in the environment`),
+			},
+		},
+	})
+}
+
+func TestResolveCredentials(t *testing.T) {
+	t.Setenv("DEVOPS_TOKEN", "")
+	t.Setenv("DEVOPS_USERNAME", "env-user")
+	t.Setenv("DEVOPS_PASSWORD", "env-password")
+
+	for name, tc := range map[string]struct {
+		config                                DevOpsProviderModel
+		token, username, password, wantSource string
+	}{
+		"config token ignores environment basic auth": {
+			config:     DevOpsProviderModel{Token: types.StringValue("cfg-token")},
+			token:      "cfg-token",
+			wantSource: "the provider block",
+		},
+		"config username takes the password from the environment": {
+			config:     DevOpsProviderModel{Username: types.StringValue("cfg-user")},
+			username:   "cfg-user",
+			password:   "env-password",
+			wantSource: "the provider block",
+		},
+		"environment is used when config sets neither": {
+			config:     DevOpsProviderModel{Password: types.StringValue("cfg-password")},
+			username:   "env-user",
+			password:   "cfg-password",
+			wantSource: "the environment",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			token, username, password, source := resolveCredentials(tc.config)
+			if token != tc.token || username != tc.username || password != tc.password || source != tc.wantSource {
+				t.Errorf("expected (%q, %q, %q, %q), got (%q, %q, %q, %q)",
+					tc.token, tc.username, tc.password, tc.wantSource, token, username, password, source)
+			}
+		})
+	}
+}
+
+func TestValidateHostURL(t *testing.T) {
+	for _, tc := range []struct {
+		host    string
+		want    string
+		wantErr bool
+	}{
+		{host: "http://localhost:8080", want: "http://localhost:8080"},
+		{host: "https://devops.example.com/api/", want: "https://devops.example.com/api"},
+		{host: "localhost:8080", wantErr: true},
+		{host: "ftp://devops.example.com", wantErr: true},
+		{host: "http://", wantErr: true},
+		{host: "http://devops.example.com?x=1", wantErr: true},
+	} {
+		got, err := validateHostURL(tc.host)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("%q: expected error, got nil", tc.host)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: expected no error, got %v", tc.host, err)
+		}
+		if got != tc.want {
+			t.Errorf("%q: expected %q, got %q", tc.host, tc.want, got)
+		}
+	}
+}
+
+func TestProvider_HostFromEnvironment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	t.Setenv("DEVOPS_HOST", server.URL)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "devops" {}
+
+data "devops_engineers" "test" {}
+`,
+			},
+			{
+				Config: `
+provider "devops" {
+  host = "localhost:8080"
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid DevOps API Host`),
+			},
+		},
+	})
+}
+
+func TestPemOrFile(t *testing.T) {
+	pemData := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+	got, err := pemOrFile(pemData)
+	if err != nil || string(got) != pemData {
+		t.Errorf("expected inline PEM to be returned as is, got %q (err=%v)", got, err)
+	}
+
+	file := filepath.Join(t.TempDir(), "cert.pem")
+	if err := os.WriteFile(file, []byte(pemData), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = pemOrFile(file)
+	if err != nil || string(got) != pemData {
+		t.Errorf("expected file contents, got %q (err=%v)", got, err)
+	}
+
+	if _, err := pemOrFile(filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Error("expected error for missing file, got nil")
+	}
+}
+
+func TestProvider_CustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "devops" {
+  host         = "` + server.URL + `"
+  ca_cert_file = "` + caFile + `"
+  max_retries  = 0
+}
+
+data "devops_engineers" "test" {}
+`,
+			},
+			{
+				Config: `
+provider "devops" {
+  host                 = "` + server.URL + `"
+  insecure_skip_verify = true
+}
+
+data "devops_engineers" "test" {}
+`,
+			},
+			{
+				Config: `
+provider "devops" {
+  host        = "` + server.URL + `"
+  client_cert = "` + caFile + `"
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Incomplete Client Certificate`),
+			},
+		},
+	})
+}
+
+func TestValidateProxyURL(t *testing.T) {
+	for _, proxy := range []string{"http://proxy:3128", "https://proxy.example.com", "socks5://127.0.0.1:1080"} {
+		if _, err := validateProxyURL(proxy); err != nil {
+			t.Errorf("%q: expected no error, got %v", proxy, err)
+		}
+	}
+
+	for _, proxy := range []string{"proxy:3128", "ftp://proxy", "http://"} {
+		if _, err := validateProxyURL(proxy); err == nil {
+			t.Errorf("%q: expected error, got nil", proxy)
+		}
+	}
+}
+
+func TestProvider_InvalidTransportConfig(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "devops" {
+  host            = "http://localhost:8080"
+  request_timeout = "-5s"
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid request_timeout`),
+			},
+			{
+				Config: `
+provider "devops" {
+  host      = "http://localhost:8080"
+  proxy_url = "proxy.internal:3128"
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid proxy_url`),
+			},
+			{
+				Config: `
+provider "devops" {
+  host      = "http://localhost:8080"
+  page_size = 0
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid page_size`),
+			},
+			{
+				Config: `
+provider "devops" {
+  host                  = "http://localhost:8080"
+  allowed_email_domains = ["alice@example.com"]
+}
+
+data "devops_engineers" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid allowed_email_domains`),
+			},
+		},
+	})
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &TeamDataSource{}
+	_ datasource.DataSourceWithConfigure      = &TeamDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &TeamDataSource{}
+)
+
+// teamDataSourceKind describes the team API a data source reads, so dev and
+// ops team data sources can share one implementation.
+type teamDataSourceKind struct {
+	// name is the singular type name suffix, e.g. "dev".
+	name string
+	// listName is the list type name suffix and attribute, e.g. "devs".
+	listName string
+	// title names the team type in diagnostics, e.g. "Dev Team".
+	title string
+	get   func(c *client.Client, ctx context.Context, id string) (teamModel, error)
+	list  func(c *client.Client, ctx context.Context) ([]teamModel, error)
+}
+
+var devTeamKind = teamDataSourceKind{
+	name:     "dev",
+	listName: "devs",
+	title:    "Dev Team",
+	get: func(c *client.Client, ctx context.Context, id string) (teamModel, error) {
+		dev, err := c.GetDev(ctx, id)
+		if err != nil {
+			return teamModel{}, err
+		}
+		return newTeamModel(dev.ID, dev.Name, dev.Engineers, dev.TeamMetadata), nil
+	},
+	list: func(c *client.Client, ctx context.Context) ([]teamModel, error) {
+		devs, err := c.GetDevs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		teams := make([]teamModel, len(devs))
+		for i, dev := range devs {
+			teams[i] = newTeamModel(dev.ID, dev.Name, dev.Engineers, dev.TeamMetadata)
+		}
+		return teams, nil
+	},
+}
+
+var opsTeamKind = teamDataSourceKind{
+	name:     "ops",
+	listName: "ops_teams",
+	title:    "Ops Team",
+	get: func(c *client.Client, ctx context.Context, id string) (teamModel, error) {
+		ops, err := c.GetOp(ctx, id)
+		if err != nil {
+			return teamModel{}, err
+		}
+		return newTeamModel(ops.ID, ops.Name, ops.Engineers, ops.TeamMetadata), nil
+	},
+	list: func(c *client.Client, ctx context.Context) ([]teamModel, error) {
+		opsTeams, err := c.GetOps(ctx)
+		if err != nil {
+			return nil, err
+		}
+		teams := make([]teamModel, len(opsTeams))
+		for i, ops := range opsTeams {
+			teams[i] = newTeamModel(ops.ID, ops.Name, ops.Engineers, ops.TeamMetadata)
+		}
+		return teams, nil
+	},
+}
+
+// teamModel maps a dev or ops team with its engineers and metadata.
+type teamModel struct {
+	ID        types.String    `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Engineers []engineerModel `tfsdk:"engineers"`
+
+	teamMetadataModel
+}
+
+func newTeamModel(id, name string, engineers []client.Engineer, metadata client.TeamMetadata) teamModel {
+	team := teamModel{
+		ID:        types.StringValue(id),
+		Name:      types.StringValue(name),
+		Engineers: make([]engineerModel, len(engineers)),
+	}
+	for i, engineer := range engineers {
+		team.Engineers[i] = newEngineerModel(engineer)
+	}
+	team.teamMetadataModel.fromClient(metadata)
+
+	return team
+}
+
+// teamEngineersAttribute is the computed engineers attribute shared by the
+// team data sources.
+func teamEngineersAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: engineerAttributes(),
+		},
+	}
+}
+
+// withTeamMetadataComputedAttributes adds the computed team metadata
+// attributes to a team data source's attributes.
+func withTeamMetadataComputedAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["description"] = schema.StringAttribute{Computed: true}
+	attributes["lead_engineer_id"] = schema.StringAttribute{Computed: true}
+	attributes["labels"] = schema.MapAttribute{Computed: true, ElementType: types.StringType}
+	attributes["slack_channel"] = schema.StringAttribute{Computed: true}
+	attributes["email_alias"] = schema.StringAttribute{Computed: true, CustomType: emailType{}}
+	return attributes
+}
+
+// NewDevDataSource is a helper function to simplify the provider implementation.
+func NewDevDataSource() datasource.DataSource {
+	return &TeamDataSource{kind: devTeamKind}
+}
+
+// NewOpsDataSource is a helper function to simplify the provider implementation.
+func NewOpsDataSource() datasource.DataSource {
+	return &TeamDataSource{kind: opsTeamKind}
+}
+
+// TeamDataSource looks up a single dev or ops team by ID or name.
+type TeamDataSource struct {
+	client *client.Client
+	kind   teamDataSourceKind
+}
+
+func (d *TeamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+// Metadata returns the data source type name.
+func (d *TeamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.kind.name
+}
+
+// Schema defines the schema for the data source.
+func (d *TeamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: withTeamMetadataComputedAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"engineers": teamEngineersAttribute(),
+		}),
+	}
+}
+
+// ValidateConfig requires exactly one of id or name. Unknown values are
+// counted as set, since they will be by the time Read runs.
+func (d *TeamDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if id.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid "+d.kind.title+" Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config teamModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var team teamModel
+
+	if !config.ID.IsNull() {
+		var err error
+		team, err = d.kind.get(d.client, ctx, config.ID.ValueString())
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"No Matching "+d.kind.title,
+				fmt.Sprintf("No %s has id %q.", strings.ToLower(d.kind.title), config.ID.ValueString()),
+			)
+			return
+		}
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Unable to Read "+d.kind.title,
+				"Could not read team "+config.ID.ValueString()+": ",
+				err,
+			)
+			return
+		}
+	} else {
+		teams, err := d.kind.list(d.client, ctx)
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Unable to Read "+d.kind.title+"s",
+				"Could not list teams to find name "+config.Name.ValueString()+": ",
+				err,
+			)
+			return
+		}
+
+		lookup := listLookup[teamModel]{
+			title:   d.kind.title,
+			key:     "name",
+			matches: func(t teamModel, name string) bool { return t.Name.ValueString() == name },
+			id:      func(t teamModel) string { return t.ID.ValueString() },
+		}
+
+		var diags diag.Diagnostics
+		team, diags = lookup.match(teams, config.Name.ValueString(), "Look the team up by id instead.")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags := resp.State.Set(ctx, &team)
+	resp.Diagnostics.Append(diags...)
+}
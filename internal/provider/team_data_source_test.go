@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-devops/internal/provider/client"
+)
+
+func TestTeamDataSources(t *testing.T) {
+	alice := client.Engineer{ID: "e1", Name: "Alice", Email: "alice@example.com"}
+	bob := client.Engineer{ID: "e2", Name: "Bob", Email: "bob@example.com"}
+
+	devs := []client.Dev{
+		{
+			ID:        "d1",
+			Name:      "Dev Team Alpha",
+			Engineers: []client.Engineer{alice, bob},
+			TeamMetadata: client.TeamMetadata{
+				Description:    "Builds the platform",
+				LeadEngineerID: "e1",
+				Labels:         map[string]string{"tier": "core"},
+			},
+		},
+		{ID: "d2", Name: "Dev Team Beta"},
+	}
+	opsTeams := []client.Ops{
+		{
+			ID:           "o1",
+			Name:         "Ops Team Alpha",
+			Engineers:    []client.Engineer{bob},
+			TeamMetadata: client.TeamMetadata{SlackChannel: "#ops-alpha", EmailAlias: "ops-alpha@example.com"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/dev":
+			json.NewEncoder(w).Encode(devs)
+		case r.Method == "GET" && r.URL.Path == "/dev/id/d1":
+			json.NewEncoder(w).Encode(devs[0])
+		case r.Method == "GET" && r.URL.Path == "/op":
+			json.NewEncoder(w).Encode(opsTeams)
+		case r.Method == "GET" && r.URL.Path == "/op/id/o1":
+			json.NewEncoder(w).Encode(opsTeams[0])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testTeamDataSourceConfigWithHost(server.URL, `
+data "devops_dev" "by_id" {
+  id = "d1"
+}
+
+data "devops_ops" "by_name" {
+  name = "Ops Team Alpha"
+}
+
+data "devops_devs" "all" {}
+
+data "devops_ops_teams" "all" {}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "name", "Dev Team Alpha"),
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "engineers.#", "2"),
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "engineers.0.email", "alice@example.com"),
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "description", "Builds the platform"),
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "lead_engineer_id", "e1"),
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "labels.tier", "core"),
+					resource.TestCheckNoResourceAttr("data.devops_dev.by_id", "slack_channel"),
+					resource.TestCheckResourceAttr("data.devops_ops.by_name", "slack_channel", "#ops-alpha"),
+					resource.TestCheckResourceAttr("data.devops_ops.by_name", "email_alias", "ops-alpha@example.com"),
+					resource.TestCheckResourceAttr("data.devops_ops.by_name", "id", "o1"),
+					resource.TestCheckResourceAttr("data.devops_ops.by_name", "engineers.0.name", "Bob"),
+					resource.TestCheckResourceAttr("data.devops_devs.all", "devs.#", "2"),
+					resource.TestCheckResourceAttr("data.devops_devs.all", "devs.1.engineers.#", "0"),
+					resource.TestCheckResourceAttr("data.devops_devs.all", "devs.0.labels.tier", "core"),
+					resource.TestCheckNoResourceAttr("data.devops_devs.all", "devs.1.description"),
+					resource.TestCheckResourceAttr("data.devops_ops_teams.all", "ops_teams.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_ops_teams.all", "ops_teams.0.engineers.0.id", "e2"),
+					resource.TestCheckResourceAttr("data.devops_ops_teams.all", "ops_teams.0.slack_channel", "#ops-alpha"),
+				),
+			},
+			{
+				Config: testTeamDataSourceConfigWithHost(server.URL, `
+data "devops_dev" "test" {
+  name = "Dev Team Gamma"
+}
+`),
+				ExpectError: regexp.MustCompile("No Matching Dev Team"),
+			},
+			{
+				Config: testTeamDataSourceConfigWithHost(server.URL, `
+data "devops_ops" "test" {}
+`),
+				ExpectError: regexp.MustCompile("Exactly one of id or name must be set"),
+			},
+		},
+	})
+}
+
+func testTeamDataSourceConfigWithHost(host, dataSources string) string {
+	return `
+provider "devops" {
+  host = "` + host + `"
+}
+` + dataSources
+}
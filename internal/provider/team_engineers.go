@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// validateTeamEngineers checks at plan time that every known ID in a team's
+// engineers set names an existing engineer, so a typo fails the plan rather
+// than the apply. IDs that are still unknown, typically because the engineer
+// is created in the same plan, are skipped. Nothing is fetched when the set
+// is unchanged from state.
+func validateTeamEngineers(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.Plan.Raw.IsNull() || c == nil {
+		return diags
+	}
+
+	var planned, prior types.Set
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("engineers"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("engineers"), &prior)...)
+	}
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() || planned.Equal(prior) {
+		return diags
+	}
+
+	var ids []string
+	for _, elem := range planned.Elements() {
+		id, ok := elem.(basetypes.StringValue)
+		if !ok || id.IsNull() || id.IsUnknown() {
+			continue
+		}
+		ids = append(ids, id.ValueString())
+	}
+	if len(ids) == 0 {
+		return diags
+	}
+
+	engineers, err := c.ListEngineers(ctx, client.EngineerFilter{IDs: ids})
+	if err != nil {
+		addAPIError(
+			&diags,
+			"Unable to Check Engineers",
+			"Could not list engineers to check the team's members: ",
+			err,
+		)
+		return diags
+	}
+
+	for _, id := range ids {
+		if slices.ContainsFunc(engineers, func(e client.Engineer) bool { return e.ID == id }) {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root("engineers").AtSetValue(types.StringValue(id)),
+			"Unknown Engineer",
+			fmt.Sprintf("No engineer has ID %q.", id),
+		)
+	}
+
+	return diags
+}
+
+// addTeamNotEmptyError reports that a team cannot be destroyed because the
+// server still lists members, naming each of them.
+func addTeamNotEmptyError(diags *diag.Diagnostics, title, teamID string, engineers []client.Engineer) {
+	members := make([]string, len(engineers))
+	for i, e := range engineers {
+		members[i] = e.ID
+		if e.Name != "" {
+			members[i] += " (" + e.Name + ")"
+		}
+	}
+
+	diags.AddError(
+		title+" Is Not Empty",
+		fmt.Sprintf("%s %s still has %d member(s): %s. "+
+			"Remove them first, or set force_destroy = true to delete the team anyway.",
+			title, teamID, len(engineers), strings.Join(members, ", ")),
+	)
+}
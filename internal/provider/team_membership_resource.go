@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+	_ resource.ResourceWithIdentity    = &TeamMembershipResource{}
+)
+
+// teamMembershipKind describes the team API a membership resource manages,
+// so dev and ops memberships can share one implementation.
+type teamMembershipKind struct {
+	typeName string
+	title    string
+	members  func(c *client.Client, ctx context.Context, teamID string) ([]client.Engineer, error)
+	add      func(c *client.Client, ctx context.Context, teamID, engineerID string) error
+	remove   func(c *client.Client, ctx context.Context, teamID, engineerID string) error
+}
+
+// NewDevMembershipResource is a helper function to simplify the provider implementation.
+func NewDevMembershipResource() resource.Resource {
+	return &TeamMembershipResource{
+		kind: teamMembershipKind{
+			typeName: "_dev_membership",
+			title:    "Dev Team",
+			members: func(c *client.Client, ctx context.Context, teamID string) ([]client.Engineer, error) {
+				dev, err := c.GetDev(ctx, teamID)
+				if err != nil {
+					return nil, err
+				}
+				return dev.Engineers, nil
+			},
+			add:    (*client.Client).AddDevEngineer,
+			remove: (*client.Client).RemoveDevEngineer,
+		},
+	}
+}
+
+// NewOpsMembershipResource is a helper function to simplify the provider implementation.
+func NewOpsMembershipResource() resource.Resource {
+	return &TeamMembershipResource{
+		kind: teamMembershipKind{
+			typeName: "_ops_membership",
+			title:    "Ops Team",
+			members: func(c *client.Client, ctx context.Context, teamID string) ([]client.Engineer, error) {
+				ops, err := c.GetOp(ctx, teamID)
+				if err != nil {
+					return nil, err
+				}
+				return ops.Engineers, nil
+			},
+			add:    (*client.Client).AddOpsEngineer,
+			remove: (*client.Client).RemoveOpsEngineer,
+		},
+	}
+}
+
+// TeamMembershipResource manages a single engineer's membership of a dev or
+// ops team. Unlike the team resources it is non-authoritative: other members
+// of the team are left alone.
+type TeamMembershipResource struct {
+	client *client.Client
+	kind   teamMembershipKind
+}
+
+type teamMembershipResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	TeamID     types.String `tfsdk:"team_id"`
+	EngineerID types.String `tfsdk:"engineer_id"`
+}
+
+// membershipID joins a team and engineer ID into the resource ID, which is
+// also the import ID.
+func membershipID(teamID, engineerID string) string {
+	return teamID + "/" + engineerID
+}
+
+// Metadata returns the resource type name.
+func (r *TeamMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.kind.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *TeamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engineer_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, engineerID := plan.TeamID.ValueString(), plan.EngineerID.ValueString()
+
+	err := r.kind.add(r.client, ctx, teamID, engineerID)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Adding "+r.kind.title+" Member",
+			"Could not add engineer "+engineerID+" to team "+teamID+": ",
+			err,
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(membershipID(teamID, engineerID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, engineerID := state.TeamID.ValueString(), state.EngineerID.ValueString()
+
+	members, err := r.kind.members(r.client, ctx, teamID)
+	if client.IsNotFound(err) {
+		// The team is gone, and the membership with it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading "+r.kind.title+" Membership",
+			"Could not read team "+teamID+": ",
+			err,
+		)
+
+		return
+	}
+
+	if !slices.ContainsFunc(members, func(e client.Engineer) bool { return e.ID == engineerID }) {
+		// The engineer was removed outside of Terraform; drop the
+		// membership so the next plan adds them back.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(membershipID(teamID, engineerID))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
+}
+
+// Update is never called with changes, since every configurable attribute
+// forces replacement, but the framework requires it.
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, engineerID := state.TeamID.ValueString(), state.EngineerID.ValueString()
+
+	err := r.kind.remove(r.client, ctx, teamID, engineerID)
+	if client.IsNotFound(err) {
+		// The team is already gone, which is what Delete wanted.
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Removing "+r.kind.title+" Member",
+			"Could not remove engineer "+engineerID+" from team "+teamID+": ",
+			err,
+		)
+		return
+	}
+}
+
+func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing membership from an ID of the form
+// "team_id/engineer_id". Read then confirms the engineer is a member.
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := importID(ctx, r.client, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, engineerID, ok := strings.Cut(id, "/")
+	if !ok || teamID == "" || engineerID == "" || strings.Contains(engineerID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form \"team_id/engineer_id\", got %q.", id),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("engineer_id"), engineerID)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *TeamMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-devops/internal/provider/client"
+)
+
+func TestDevMembershipResource(t *testing.T) {
+	// e1 is managed elsewhere and must survive the membership's lifecycle.
+	team := client.Dev{ID: "t1", Name: "Dev Team Alpha", Engineers: []client.Engineer{{ID: "e1"}}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/dev/id/t1":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(team)
+		case r.Method == "PUT" && r.URL.Path == "/dev/t1":
+			json.NewDecoder(r.Body).Decode(&team)
+			team.ID = "t1"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(team)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	hasMember := func(id string) bool {
+		return slices.ContainsFunc(team.Engineers, func(e client.Engineer) bool { return e.ID == id })
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if hasMember("e2") || !hasMember("e1") {
+				t.Errorf("expected only e1 to remain after destroy, got %+v", team.Engineers)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTeamMembershipConfigWithHost(server.URL, "dev", "t1", "e2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_dev_membership.test", "id", "t1/e2"),
+					resource.TestCheckResourceAttr("devops_dev_membership.test", "team_id", "t1"),
+					resource.TestCheckResourceAttr("devops_dev_membership.test", "engineer_id", "e2"),
+					func(*terraform.State) error {
+						if !hasMember("e1") || !hasMember("e2") {
+							t.Errorf("expected team to keep e1 and gain e2, got %+v", team.Engineers)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "devops_dev_membership.test",
+				ImportState:       true,
+				ImportStateId:     "t1/e2",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "devops_dev_membership.test",
+				ImportState:   true,
+				ImportStateId: "t1",
+				ExpectError:   regexp.MustCompile("Invalid Import ID"),
+			},
+		},
+	})
+}
+
+func TestOpsMembershipResource(t *testing.T) {
+	team := client.Ops{ID: "t1", Name: "Ops Team Alpha", Engineers: []client.Engineer{{ID: "e1"}}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/op/id/t1":
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(team)
+		case r.Method == "PUT" && r.URL.Path == "/op/t1":
+			json.NewDecoder(r.Body).Decode(&team)
+			team.ID = "t1"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(team)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testTeamMembershipConfigWithHost(server.URL, "ops", "t1", "e2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_ops_membership.test", "id", "t1/e2"),
+				),
+			},
+			{
+				ResourceName:    "devops_ops_membership.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testTeamMembershipConfigWithHost(host, kind, teamID, engineerID string) string {
+	return `
+provider "devops" {
+  host = "` + host + `"
+}
+
+resource "devops_` + kind + `_membership" "test" {
+  team_id     = "` + teamID + `"
+  engineer_id = "` + engineerID + `"
+}
+`
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// teamMetadataModel holds the descriptive attributes shared by the dev and
+// ops team resources and data sources. It is embedded in each team model.
+type teamMetadataModel struct {
+	Description    types.String `tfsdk:"description"`
+	LeadEngineerID types.String `tfsdk:"lead_engineer_id"`
+	Labels         types.Map    `tfsdk:"labels"`
+	SlackChannel   types.String `tfsdk:"slack_channel"`
+	EmailAlias     emailValue   `tfsdk:"email_alias"`
+}
+
+// teamMetadataAttributes returns the schema attributes for teamMetadataModel.
+func teamMetadataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"description": schema.StringAttribute{
+			Optional:   true,
+			Validators: []validator.String{stringNotEmpty()},
+		},
+		"lead_engineer_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "ID of the engineer leading the team. Must be one of the team's engineers.",
+			Validators:  []validator.String{stringNotEmpty()},
+		},
+		"labels": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"slack_channel": schema.StringAttribute{
+			Optional:   true,
+			Validators: []validator.String{stringNotEmpty()},
+		},
+		"email_alias": schema.StringAttribute{
+			Optional:   true,
+			CustomType: emailType{},
+			Validators: []validator.String{emailAddress()},
+		},
+	}
+}
+
+// withTeamMetadataAttributes adds the metadata attributes to a team schema's
+// attributes.
+func withTeamMetadataAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	for name, attribute := range teamMetadataAttributes() {
+		attributes[name] = attribute
+	}
+	return attributes
+}
+
+// toClient builds the API metadata from the model. Null attributes are sent
+// as empty values, which the API treats as unset. The email alias is
+// normalized the same way as engineer emails.
+func (m teamMetadataModel) toClient(ctx context.Context) (client.TeamMetadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metadata := client.TeamMetadata{
+		Description:    m.Description.ValueString(),
+		LeadEngineerID: m.LeadEngineerID.ValueString(),
+		SlackChannel:   m.SlackChannel.ValueString(),
+		EmailAlias:     normalizeEmail(m.EmailAlias.ValueString()),
+	}
+
+	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
+		diags.Append(m.Labels.ElementsAs(ctx, &metadata.Labels, false)...)
+	}
+
+	return metadata, diags
+}
+
+// fromClient copies API metadata into the model. Empty values become null,
+// except that an empty labels map already in the model is kept, since the
+// API does not distinguish it from no labels.
+func (m *teamMetadataModel) fromClient(metadata client.TeamMetadata) {
+	m.Description = stringOrNull(metadata.Description)
+	m.LeadEngineerID = stringOrNull(metadata.LeadEngineerID)
+	m.SlackChannel = stringOrNull(metadata.SlackChannel)
+	m.EmailAlias = emailValue{StringValue: stringOrNull(metadata.EmailAlias)}
+
+	switch {
+	case len(metadata.Labels) > 0:
+		labels := make(map[string]attr.Value, len(metadata.Labels))
+		for key, value := range metadata.Labels {
+			labels[key] = types.StringValue(value)
+		}
+		m.Labels = types.MapValueMust(types.StringType, labels)
+	case !m.Labels.IsNull() && !m.Labels.IsUnknown() && len(m.Labels.Elements()) == 0:
+		m.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	default:
+		m.Labels = types.MapNull(types.StringType)
+	}
+}
+
+// validateTeamLead checks that a configured lead_engineer_id is one of the
+// team's engineers. Nothing is checked while the lead or any engineer ID is
+// still unknown.
+func validateTeamLead(ctx context.Context, req resource.ValidateConfigRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var lead types.String
+	var engineers types.Set
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("lead_engineer_id"), &lead)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("engineers"), &engineers)...)
+	if diags.HasError() || lead.IsNull() || lead.IsUnknown() || engineers.IsNull() || engineers.IsUnknown() {
+		return diags
+	}
+
+	for _, elem := range engineers.Elements() {
+		if elem.IsUnknown() {
+			return diags
+		}
+		if elem.Equal(lead) {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("lead_engineer_id"),
+		"Lead Engineer Is Not a Member",
+		fmt.Sprintf("lead_engineer_id %q must also be listed in engineers.", lead.ValueString()),
+	)
+
+	return diags
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTeamMetadataModel(t *testing.T) {
+	ctx := context.Background()
+
+	model := teamMetadataModel{
+		Description:    types.StringValue("Builds the platform"),
+		LeadEngineerID: types.StringNull(),
+		Labels:         types.MapValueMust(types.StringType, map[string]attr.Value{"tier": types.StringValue("core")}),
+		SlackChannel:   types.StringNull(),
+		EmailAlias:     newEmailValue(" Dev-Alpha@Example.com"),
+	}
+
+	got, diags := model.toClient(ctx)
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+	want := client.TeamMetadata{
+		Description: "Builds the platform",
+		Labels:      map[string]string{"tier": "core"},
+		EmailAlias:  "dev-alpha@example.com",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	var read teamMetadataModel
+	read.fromClient(got)
+	if !read.LeadEngineerID.IsNull() || !read.SlackChannel.IsNull() {
+		t.Errorf("expected unset fields to be null, got %s and %s", read.LeadEngineerID, read.SlackChannel)
+	}
+	if !read.Labels.Equal(model.Labels) || read.EmailAlias.ValueString() != "dev-alpha@example.com" {
+		t.Errorf("expected labels and alias to round-trip, got %s and %s", read.Labels, read.EmailAlias)
+	}
+
+	// An empty labels map is kept, since the API drops it.
+	read.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	read.fromClient(client.TeamMetadata{})
+	if read.Labels.IsNull() || len(read.Labels.Elements()) != 0 {
+		t.Errorf("expected an empty labels map to be kept, got %s", read.Labels)
+	}
+}
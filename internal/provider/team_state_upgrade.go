@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// teamResourceModelV0 is the dev and ops team state before engineers became
+// a set.
+type teamResourceModelV0 struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Engineers types.List   `tfsdk:"engineers"`
+}
+
+// teamSchemaV0 is the dev and ops team schema at version 0.
+var teamSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"engineers": schema.ListAttribute{
+			Required:    true,
+			ElementType: types.StringType,
+		},
+	},
+}
+
+// teamStateUpgraders migrates dev and ops team state to the current schema
+// version. Both resources share the same shape, so they share upgraders.
+func teamStateUpgraders() map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &teamSchemaV0,
+			StateUpgrader: upgradeTeamStateV0,
+		},
+	}
+}
+
+// upgradeTeamStateV0 turns the engineers list into a set, dropping any
+// duplicate IDs the list allowed.
+func upgradeTeamStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior teamResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var engineerIDs []string
+	resp.Diagnostics.Append(prior.Engineers.ElementsAs(ctx, &engineerIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unique := make([]string, 0, len(engineerIDs))
+	seen := make(map[string]bool, len(engineerIDs))
+	for _, id := range engineerIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	engineers, diags := types.SetValueFrom(ctx, types.StringType, unique)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// devResourceModel and opsResourceModel are identical, so either
+	// describes the upgraded state.
+	upgraded := devResourceModel{
+		ID:           prior.ID,
+		Name:         prior.Name,
+		Engineers:    engineers,
+		ForceDestroy: types.BoolValue(false),
+		// Metadata did not exist at version 0; Read fills it in.
+		teamMetadataModel: teamMetadataModel{
+			Labels: types.MapNull(types.StringType),
+		},
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+}
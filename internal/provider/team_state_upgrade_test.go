@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeTeamStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&DevResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	priorType := teamSchemaV0.Type().TerraformType(ctx)
+	prior := tftypes.NewValue(priorType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "dev-1"),
+		"name": tftypes.NewValue(tftypes.String, "Dev Team Alpha"),
+		"engineers": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "e2"),
+			tftypes.NewValue(tftypes.String, "e1"),
+			tftypes.NewValue(tftypes.String, "e2"),
+		}),
+	})
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: teamSchemaV0, Raw: prior},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+
+	upgradeTeamStateV0(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got devResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading upgraded state: %v", diags)
+	}
+
+	if got.ID.ValueString() != "dev-1" || got.Name.ValueString() != "Dev Team Alpha" {
+		t.Errorf("got id %s, name %s", got.ID, got.Name)
+	}
+
+	if got.ForceDestroy.IsNull() || got.ForceDestroy.ValueBool() {
+		t.Errorf("force_destroy = %s, want false", got.ForceDestroy)
+	}
+
+	var engineers []string
+	got.Engineers.ElementsAs(ctx, &engineers, false)
+	slices.Sort(engineers)
+	if !slices.Equal(engineers, []string{"e1", "e2"}) {
+		t.Errorf("engineers = %v, want [e1 e2]", engineers)
+	}
+}
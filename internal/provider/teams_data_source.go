@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devops/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &TeamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TeamsDataSource{}
+)
+
+// NewDevsDataSource is a helper function to simplify the provider implementation.
+func NewDevsDataSource() datasource.DataSource {
+	return &TeamsDataSource{kind: devTeamKind}
+}
+
+// NewOpsTeamsDataSource is a helper function to simplify the provider implementation.
+func NewOpsTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{kind: opsTeamKind}
+}
+
+// TeamsDataSource lists every dev or ops team.
+type TeamsDataSource struct {
+	client *client.Client
+	kind   teamDataSourceKind
+}
+
+func (d *TeamsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+// Metadata returns the data source type name.
+func (d *TeamsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.kind.listName
+}
+
+// Schema defines the schema for the data source.
+func (d *TeamsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			d.kind.listName: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: withTeamMetadataComputedAttributes(map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"engineers": teamEngineersAttribute(),
+					}),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	teams, err := d.kind.list(d.client, ctx)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Unable to Read "+d.kind.title+"s",
+			"",
+			err,
+		)
+		return
+	}
+
+	// The list attribute is named per kind, so it is set by path rather
+	// than through a model struct.
+	diags := resp.State.SetAttribute(ctx, path.Root(d.kind.listName), teams)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	// Embed the IANA zone database so timezone validation does not depend
+	// on the zoneinfo files of the machine running Terraform.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = emailAddressValidator{}
+
+// emailAddressValidator checks that a string is a bare RFC 5322 address,
+// without a display name or angle brackets.
+type emailAddressValidator struct{}
+
+// emailAddress returns a validator that rejects malformed email addresses.
+func emailAddress() validator.String {
+	return emailAddressValidator{}
+}
+
+func (v emailAddressValidator) Description(_ context.Context) string {
+	return "value must be an email address such as name@example.com"
+}
+
+func (v emailAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailAddressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	addr, err := mail.ParseAddress(value)
+	// ParseAddress also accepts "Name <addr>" forms and comments; only the
+	// bare address is stored, so anything around it is rejected.
+	if err == nil && (addr.Name != "" || strings.ContainsAny(value, "<>()") || value != strings.TrimSpace(value)) {
+		err = fmt.Errorf("expected a bare address like %q", addr.Address)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Email Address",
+			fmt.Sprintf("%q is not a valid email address: %s.", value, err),
+		)
+	}
+}
+
+var _ validator.String = stringOneOfValidator{}
+
+// stringOneOfValidator rejects strings outside a fixed set of values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+// stringOneOf returns a validator that accepts only the given values.
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return "value must be one of: " + strings.Join(v.values, ", ")
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("%s must be one of %s, got %q.", req.Path, strings.Join(v.values, ", "), req.ConfigValue.ValueString()),
+	)
+}
+
+var _ validator.String = stringCheckValidator{}
+
+// stringCheckValidator runs check on a known string and reports the error it
+// returns under summary.
+type stringCheckValidator struct {
+	description string
+	summary     string
+	check       func(string) error
+}
+
+func (v stringCheckValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v stringCheckValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringCheckValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			v.summary,
+			fmt.Sprintf("Invalid %s: %s.", req.Path, err),
+		)
+	}
+}
+
+// stringNotEmpty rejects the empty string. Optional API fields treat "" as
+// unset, so an explicit "" would never round-trip; omit the attribute instead.
+func stringNotEmpty() validator.String {
+	return stringCheckValidator{
+		description: "value must not be empty",
+		summary:     "Invalid Attribute Value",
+		check: func(v string) error {
+			if v == "" {
+				return errors.New("value must not be empty; omit the attribute to leave it unset")
+			}
+			return nil
+		},
+	}
+}
+
+// ianaTimezone accepts IANA time zone names such as "Europe/Berlin" or "UTC".
+func ianaTimezone() validator.String {
+	return stringCheckValidator{
+		description: "value must be an IANA time zone name such as Europe/Berlin",
+		summary:     "Invalid Time Zone",
+		check: func(v string) error {
+			// LoadLocation also accepts "" and "Local", which name the
+			// machine's zone rather than an IANA one.
+			if v == "" || v == "Local" {
+				return fmt.Errorf("%q is not an IANA time zone name", v)
+			}
+			if _, err := time.LoadLocation(v); err != nil {
+				return fmt.Errorf("%q is not an IANA time zone name", v)
+			}
+			return nil
+		},
+	}
+}
+
+// rfc3339Date accepts RFC 3339 full-dates such as "2024-03-01".
+func rfc3339Date() validator.String {
+	return stringCheckValidator{
+		description: "value must be an RFC 3339 date such as 2024-03-01",
+		summary:     "Invalid Date",
+		check: func(v string) error {
+			if _, err := time.Parse(time.DateOnly, v); err != nil {
+				return fmt.Errorf("%q is not an RFC 3339 date (YYYY-MM-DD)", v)
+			}
+			return nil
+		},
+	}
+}
+
+// githubUsernamePattern is GitHub's username rule: letters, digits and single
+// hyphens, not at either end.
+var githubUsernamePattern = regexp.MustCompile(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`)
+
+// githubUsername accepts valid GitHub usernames of up to 39 characters.
+func githubUsername() validator.String {
+	return stringCheckValidator{
+		description: "value must be a GitHub username",
+		summary:     "Invalid GitHub Username",
+		check: func(v string) error {
+			if len(v) > 39 || !githubUsernamePattern.MatchString(v) {
+				return fmt.Errorf("%q is not a GitHub username: use up to 39 letters, digits and single hyphens, not starting or ending with a hyphen", v)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEmailAddressValidator(t *testing.T) {
+	for value, wantErr := range map[string]bool{
+		"alice@example.com":         false,
+		"Alice.Smith@Example.COM":   false,
+		`"alice smith"@example.com`: false,
+		"alice+ops@sub.example.com": false,
+		"alice.example.com":         true,
+		"alice@":                    true,
+		"@example.com":              true,
+		"Alice <alice@example.com>": true,
+		"alice@example.com ":        true,
+		"":                          true,
+	} {
+		req := validator.StringRequest{
+			Path:        path.Root("email"),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := &validator.StringResponse{}
+
+		emailAddress().ValidateString(context.Background(), req, resp)
+
+		if got := resp.Diagnostics.HasError(); got != wantErr {
+			t.Errorf("%q: HasError() = %v, want %v: %v", value, got, wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestStringCheckValidators(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		validator validator.String
+		valid     []string
+		invalid   []string
+	}{
+		{
+			name:      "stringNotEmpty",
+			validator: stringNotEmpty(),
+			valid:     []string{"x"},
+			invalid:   []string{""},
+		},
+		{
+			name:      "ianaTimezone",
+			validator: ianaTimezone(),
+			valid:     []string{"UTC", "Europe/Berlin", "America/Argentina/Buenos_Aires"},
+			invalid:   []string{"", "Local", "Mars/Olympus_Mons", "CEST", "+02:00"},
+		},
+		{
+			name:      "rfc3339Date",
+			validator: rfc3339Date(),
+			valid:     []string{"2024-03-01", "2000-02-29"},
+			invalid:   []string{"2024-3-1", "2023-02-29", "01/03/2024", "2024-03-01T09:00:00Z"},
+		},
+		{
+			name:      "githubUsername",
+			validator: githubUsername(),
+			valid:     []string{"octocat", "mona-lisa", "a1", "x123456789012345678901234567890123456789"[:39]},
+			invalid:   []string{"-octocat", "octocat-", "octo--cat", "octo_cat", "x123456789012345678901234567890123456789"},
+		},
+		{
+			name:      "stringOneOf",
+			validator: stringOneOf("fail", "detach"),
+			valid:     []string{"fail", "detach"},
+			invalid:   []string{"", "Fail", "cascade"},
+		},
+	} {
+		for _, value := range append(tc.valid, tc.invalid...) {
+			req := validator.StringRequest{
+				Path:        path.Root("attr"),
+				ConfigValue: types.StringValue(value),
+			}
+			resp := &validator.StringResponse{}
+
+			tc.validator.ValidateString(context.Background(), req, resp)
+
+			if wantErr := slices.Contains(tc.invalid, value); resp.Diagnostics.HasError() != wantErr {
+				t.Errorf("%s(%q): HasError() = %v, want %v: %v", tc.name, value, resp.Diagnostics.HasError(), wantErr, resp.Diagnostics)
+			}
+		}
+	}
+}
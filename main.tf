@@ -10,11 +10,11 @@ provider "devops" {
   host = "http://localhost:8080"
 }
 
-data "devops_engineer" "engineer" {
+data "devops_engineers" "engineer" {
 }
 
 output "engineer" {
-  value = data.devops_engineer.engineer
+  value = data.devops_engineers.engineer
 }
 
 resource "devops_engineer" "me" {